@@ -0,0 +1,6 @@
+data "netbox_aggregates" "ripe" {
+  filter {
+    name  = "rir"
+    value = "ripe"
+  }
+}
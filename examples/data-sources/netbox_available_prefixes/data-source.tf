@@ -0,0 +1,15 @@
+data "netbox_prefix" "pod" {
+  prefix = "10.20.0.0/22"
+}
+
+data "netbox_available_prefixes" "pod" {
+  prefix_id     = data.netbox_prefix.pod.id
+  prefix_length = 28
+}
+
+check "pod_capacity" {
+  assert {
+    condition     = data.netbox_available_prefixes.pod.prefix_count >= 16
+    error_message = "${data.netbox_prefix.pod.prefix} has room for only ${data.netbox_available_prefixes.pod.prefix_count} /28s."
+  }
+}
@@ -0,0 +1,6 @@
+data "netbox_device_types" "front_to_rear" {
+  filter {
+    name  = "airflow"
+    value = "front-to-rear"
+  }
+}
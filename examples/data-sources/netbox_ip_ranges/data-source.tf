@@ -0,0 +1,10 @@
+data "netbox_ip_ranges" "dhcp_scopes" {
+  filter {
+    name  = "parent"
+    value = "10.0.0.0/16"
+  }
+  filter {
+    name  = "status"
+    value = "active"
+  }
+}
@@ -0,0 +1,14 @@
+data "netbox_prefix" "site_a" {
+  prefix = "10.0.0.0/16"
+}
+
+data "netbox_prefix_tree" "site_a" {
+  prefix_id = data.netbox_prefix.site_a.id
+}
+
+output "ip_plan" {
+  value = [
+    for p in data.netbox_prefix_tree.site_a.prefixes :
+    "${join("", [for i in range(p.depth) : "  "])}${p.prefix} ${p.description} (${p.utilization}% used)"
+  ]
+}
@@ -0,0 +1,10 @@
+data "netbox_rirs" "public" {
+  filter {
+    name  = "is_private"
+    value = "false"
+  }
+}
+
+output "public_rir_ids" {
+  value = { for rir in data.netbox_rirs.public.rirs : rir.slug => rir.id }
+}
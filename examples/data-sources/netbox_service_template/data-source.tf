@@ -0,0 +1,3 @@
+data "netbox_service_template" "https" {
+  name = "https"
+}
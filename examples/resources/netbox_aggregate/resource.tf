@@ -5,4 +5,5 @@ resource "netbox_aggregate" "test" {
   prefix      = "1.1.1.0/25"
   description = "my description"
   rir_id      = netbox_rir.test.id
+  date_added  = "2021-03-01"
 }
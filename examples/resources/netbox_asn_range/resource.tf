@@ -0,0 +1,11 @@
+resource "netbox_rir" "private" {
+  name       = "RFC 6996"
+  is_private = true
+}
+
+resource "netbox_asn_range" "fabric" {
+  name   = "EVPN fabric"
+  rir_id = netbox_rir.private.id
+  start  = 4200000000
+  end    = 4200000999
+}
@@ -0,0 +1,8 @@
+data "netbox_asn_range" "fabric" {
+  name = "EVPN fabric"
+}
+
+resource "netbox_available_asn" "leaf01" {
+  asn_range_id = data.netbox_asn_range.fabric.id
+  description  = "leaf01/leaf02"
+}
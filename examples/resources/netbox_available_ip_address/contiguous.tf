@@ -0,0 +1,10 @@
+data "netbox_prefix" "test" {
+  cidr = "10.0.0.0/24"
+}
+
+resource "netbox_available_ip_address" "vip_pool" {
+  prefix_id     = data.netbox_prefix.test.id
+  address_count = 8
+  contiguous    = true
+  status        = "reserved"
+}
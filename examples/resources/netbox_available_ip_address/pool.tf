@@ -0,0 +1,11 @@
+data "netbox_tag" "lb_pool" {
+  name = "lb-pool"
+}
+
+// Allocates from the first pool prefix tagged lb-pool that has an available IP address
+resource "netbox_available_ip_address" "vip" {
+  prefix_selector {
+    tag     = data.netbox_tag.lb_pool.slug
+    is_pool = true
+  }
+}
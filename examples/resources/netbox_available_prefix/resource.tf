@@ -7,3 +7,18 @@ resource "netbox_available_prefix" "test" {
   prefix_length    = 25
   status           = "active"
 }
+
+data "netbox_tag" "environments" {
+  name = "environments"
+}
+
+// Allocates one /26 per environment from the first tagged container with enough space
+resource "netbox_available_prefix" "environments" {
+  prefix_selector {
+    tag              = data.netbox_tag.environments.slug
+    min_free_percent = 10
+  }
+  prefix_length = 26
+  prefix_count  = 4
+  status        = "active"
+}
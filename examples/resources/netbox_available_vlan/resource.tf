@@ -0,0 +1,9 @@
+data "netbox_vlan_group" "customers" {
+  name = "customers"
+}
+
+resource "netbox_available_vlan" "customer" {
+  group_id = data.netbox_vlan_group.customers.id
+  name     = "customer-a"
+  status   = "active"
+}
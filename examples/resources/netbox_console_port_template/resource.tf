@@ -0,0 +1,14 @@
+resource "netbox_manufacturer" "test" {
+  name = "my-manufacturer"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "test-model"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_console_port_template" "test" {
+  name           = "console"
+  type           = "rj-45"
+  device_type_id = netbox_device_type.test.id
+}
@@ -0,0 +1,16 @@
+resource "netbox_manufacturer" "test" {
+  name = "my-manufacturer"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "console-server"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_console_server_port_template" "test" {
+  count = 16
+
+  name           = "port${count.index + 1}"
+  type           = "rj-45"
+  device_type_id = netbox_device_type.test.id
+}
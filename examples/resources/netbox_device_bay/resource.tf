@@ -0,0 +1,20 @@
+# Note that some terraform code is not included in the example for brevity
+
+resource "netbox_device_type" "chassis" {
+  model           = "Blade Chassis"
+  manufacturer_id = netbox_manufacturer.test.id
+  u_height        = 10
+  subdevice_role  = "parent"
+}
+
+resource "netbox_device" "chassis" {
+  name           = "chassis01"
+  device_type_id = netbox_device_type.chassis.id
+  role_id        = netbox_device_role.test.id
+  site_id        = netbox_site.test.id
+}
+
+resource "netbox_device_bay" "slot1" {
+  device_id = netbox_device.chassis.id
+  name      = "Slot 1"
+}
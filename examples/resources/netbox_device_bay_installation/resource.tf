@@ -0,0 +1,20 @@
+# Note that some terraform code is not included in the example for brevity
+
+resource "netbox_device_type" "blade" {
+  model           = "Blade"
+  manufacturer_id = netbox_manufacturer.test.id
+  u_height        = 0
+  subdevice_role  = "child"
+}
+
+resource "netbox_device" "blade" {
+  name           = "blade01"
+  device_type_id = netbox_device_type.blade.id
+  role_id        = netbox_device_role.test.id
+  site_id        = netbox_site.test.id
+}
+
+resource "netbox_device_bay_installation" "blade" {
+  device_bay_id = netbox_device_bay.slot1.id
+  device_id     = netbox_device.blade.id
+}
@@ -0,0 +1,16 @@
+resource "netbox_manufacturer" "test" {
+  name = "my-manufacturer"
+}
+
+resource "netbox_device_type" "blade_chassis" {
+  model           = "blade-chassis"
+  manufacturer_id = netbox_manufacturer.test.id
+  subdevice_role  = "parent"
+}
+
+resource "netbox_device_bay_template" "bay" {
+  count = 8
+
+  name           = "bay${count.index + 1}"
+  device_type_id = netbox_device_type.blade_chassis.id
+}
@@ -0,0 +1,19 @@
+// Assumes a device with ID 123 exists
+resource "netbox_device_interfaces" "switch" {
+  device_id     = 123
+  authoritative = true
+
+  dynamic "interface" {
+    for_each = range(1, 49)
+    content {
+      name = "GigabitEthernet1/0/${interface.value}"
+      type = "1000base-t"
+    }
+  }
+
+  interface {
+    name     = "mgmt0"
+    type     = "1000base-t"
+    mgmtonly = true
+  }
+}
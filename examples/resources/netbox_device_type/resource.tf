@@ -7,3 +7,13 @@ resource "netbox_device_type" "test" {
   part_number     = "123"
   manufacturer_id = netbox_manufacturer.test.id
 }
+
+resource "netbox_device_type" "switch" {
+  model           = "switch"
+  manufacturer_id = netbox_manufacturer.test.id
+  u_height        = 1
+  airflow         = "front-to-rear"
+  weight          = 7.5
+  weight_unit     = "kg"
+  front_image     = "${path.module}/images/switch-front.png"
+}
@@ -0,0 +1,33 @@
+resource "netbox_manufacturer" "cisco" {
+  name = "Cisco"
+}
+
+// Assumes a checkout of https://github.com/netbox-community/devicetype-library next to the configuration
+resource "netbox_device_type_definition" "c9300_48p" {
+  definition = file("${path.module}/devicetype-library/device-types/Cisco/C9300-48P.yaml")
+
+  depends_on = [netbox_manufacturer.cisco]
+}
+
+// A decoded definition can be adjusted before it is passed on
+resource "netbox_device_type_definition" "patch_panel" {
+  definition = yamlencode({
+    manufacturer = netbox_manufacturer.cisco.name
+    model        = "Patch Panel 24"
+    slug         = "patch-panel-24"
+    u_height     = 1
+    rear-ports = [
+      { name = "rear", type = "mpo", positions = 24 },
+    ]
+    front-ports = [
+      for i in range(1, 25) : { name = "port${i}", type = "lc", rear_port = "rear", rear_port_position = i }
+    ]
+  })
+}
+
+resource "netbox_device" "switch" {
+  name           = "switch01"
+  device_type_id = netbox_device_type_definition.c9300_48p.id
+  role_id        = 1
+  site_id        = 1
+}
@@ -0,0 +1,15 @@
+resource "netbox_fhrp_group" "gateway" {
+  name      = "vlan100-gateway"
+  protocol  = "vrrp3"
+  group_id  = 100
+  auth_type = "md5"
+  auth_key  = var.vrrp_auth_key
+}
+
+resource "netbox_ip_address" "gateway_vip" {
+  ip_address   = "10.0.100.1/24"
+  status       = "active"
+  role         = "vrrp"
+  object_type  = "ipam.fhrpgroup"
+  interface_id = netbox_fhrp_group.gateway.id
+}
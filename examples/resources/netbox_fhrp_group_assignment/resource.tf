@@ -0,0 +1,11 @@
+resource "netbox_fhrp_group_assignment" "router1" {
+  group_id            = netbox_fhrp_group.gateway.id
+  device_interface_id = netbox_device_interface.router1_vlan100.id
+  priority            = 200
+}
+
+resource "netbox_fhrp_group_assignment" "router2" {
+  group_id            = netbox_fhrp_group.gateway.id
+  device_interface_id = netbox_device_interface.router2_vlan100.id
+  priority            = 100
+}
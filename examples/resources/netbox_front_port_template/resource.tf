@@ -0,0 +1,26 @@
+resource "netbox_manufacturer" "test" {
+  name = "my-manufacturer"
+}
+
+resource "netbox_device_type" "patch_panel" {
+  model           = "patch-panel"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_rear_port_template" "rear" {
+  name           = "rear"
+  type           = "mpo"
+  positions      = 12
+  device_type_id = netbox_device_type.patch_panel.id
+}
+
+// Maps each front port to one position of the rear port
+resource "netbox_front_port_template" "front" {
+  count = 12
+
+  name                  = "front${count.index + 1}"
+  type                  = "lc"
+  rear_port_template_id = netbox_rear_port_template.rear.id
+  rear_port_position    = count.index + 1
+  device_type_id        = netbox_device_type.patch_panel.id
+}
@@ -0,0 +1,14 @@
+resource "netbox_ike_proposal" "aes256_sha256" {
+  name                     = "aes256-sha256-dh14"
+  authentication_method    = "preshared-keys"
+  encryption_algorithm     = "aes-256-cbc"
+  authentication_algorithm = "hmac-sha256"
+  group                    = 14
+}
+
+resource "netbox_ike_policy" "site_to_site" {
+  name          = "site-to-site-ikev2"
+  version       = 2
+  proposal_ids  = [netbox_ike_proposal.aes256_sha256.id]
+  preshared_key = var.ike_preshared_key
+}
@@ -0,0 +1,12 @@
+resource "netbox_fhrp_group" "gateway" {
+  protocol = "vrrp3"
+  group_id = 100
+}
+
+resource "netbox_ip_address" "gateway_vip" {
+  ip_address   = "10.0.100.1/24"
+  status       = "active"
+  role         = "vrrp"
+  object_type  = "ipam.fhrpgroup"
+  interface_id = netbox_fhrp_group.gateway.id
+}
@@ -3,3 +3,10 @@ resource "netbox_ip_range" "cust_a_prod" {
   end_address   = "10.0.0.50/24"
   tags          = ["customer-a", "prod"]
 }
+
+resource "netbox_ip_range" "dhcp_pool" {
+  start_address = "10.0.1.100/24"
+  end_address   = "10.0.1.199/24"
+  description   = "DHCP pool"
+  mark_utilized = true
+}
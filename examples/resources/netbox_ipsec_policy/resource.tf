@@ -0,0 +1,10 @@
+resource "netbox_ipsec_proposal" "aes256_gcm" {
+  name                 = "esp-aes256-gcm"
+  encryption_algorithm = "aes-256-gcm"
+}
+
+resource "netbox_ipsec_policy" "site_to_site" {
+  name         = "site-to-site-esp"
+  proposal_ids = [netbox_ipsec_proposal.aes256_gcm.id]
+  pfs_group    = 14
+}
@@ -0,0 +1,26 @@
+data "netbox_ike_policy" "site_to_site" {
+  name = "site-to-site-ikev2"
+}
+
+data "netbox_ipsec_policy" "site_to_site" {
+  name = "site-to-site-esp"
+}
+
+resource "netbox_ipsec_profile" "site_to_site" {
+  name            = "site-to-site"
+  mode            = "esp"
+  ike_policy_id   = data.netbox_ike_policy.site_to_site.id
+  ipsec_policy_id = data.netbox_ipsec_policy.site_to_site.id
+}
+
+resource "netbox_vpn_tunnel_group" "site_to_site" {
+  name = "site-to-site"
+}
+
+resource "netbox_vpn_tunnel" "branch" {
+  name             = "hq-branch"
+  encapsulation    = "ipsec-tunnel"
+  status           = "active"
+  tunnel_group_id  = netbox_vpn_tunnel_group.site_to_site.id
+  ipsec_profile_id = netbox_ipsec_profile.site_to_site.id
+}
@@ -0,0 +1,5 @@
+resource "netbox_ipsec_proposal" "aes256_gcm" {
+  name                 = "esp-aes256-gcm"
+  encryption_algorithm = "aes-256-gcm"
+  sa_lifetime_seconds  = 3600
+}
@@ -0,0 +1,11 @@
+resource "netbox_route_target" "vni_10100" {
+  name = "65000:10100"
+}
+
+resource "netbox_l2vpn" "servers" {
+  name              = "servers"
+  type              = "vxlan-evpn"
+  identifier        = 10100
+  import_target_ids = [netbox_route_target.vni_10100.id]
+  export_target_ids = [netbox_route_target.vni_10100.id]
+}
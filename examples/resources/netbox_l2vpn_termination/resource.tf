@@ -0,0 +1,9 @@
+resource "netbox_vlan" "servers" {
+  name = "servers"
+  vid  = 100
+}
+
+resource "netbox_l2vpn_termination" "servers" {
+  l2vpn_id = netbox_l2vpn.servers.id
+  vlan_id  = netbox_vlan.servers.id
+}
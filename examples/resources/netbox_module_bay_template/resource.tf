@@ -0,0 +1,16 @@
+resource "netbox_manufacturer" "test" {
+  name = "my-manufacturer"
+}
+
+resource "netbox_device_type" "chassis" {
+  model           = "chassis"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_module_bay_template" "slot" {
+  count = 4
+
+  name           = "slot${count.index + 1}"
+  position       = count.index + 1
+  device_type_id = netbox_device_type.chassis.id
+}
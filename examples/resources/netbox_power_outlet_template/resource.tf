@@ -0,0 +1,24 @@
+resource "netbox_manufacturer" "test" {
+  name = "my-manufacturer"
+}
+
+resource "netbox_device_type" "pdu" {
+  model           = "pdu"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_power_port_template" "inlet" {
+  name           = "inlet"
+  type           = "iec-60309-3p-n-e-6h"
+  device_type_id = netbox_device_type.pdu.id
+}
+
+resource "netbox_power_outlet_template" "outlet" {
+  count = 6
+
+  name                   = "outlet${count.index + 1}"
+  type                   = "iec-60320-c13"
+  power_port_template_id = netbox_power_port_template.inlet.id
+  feed_leg               = ["A", "B", "C"][count.index % 3]
+  device_type_id         = netbox_device_type.pdu.id
+}
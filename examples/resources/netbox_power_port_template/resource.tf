@@ -0,0 +1,17 @@
+resource "netbox_manufacturer" "test" {
+  name = "my-manufacturer"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "test-model"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_power_port_template" "psu" {
+  count = 2
+
+  name           = "PSU${count.index}"
+  type           = "iec-60320-c14"
+  maximum_draw   = 750
+  device_type_id = netbox_device_type.test.id
+}
@@ -0,0 +1,15 @@
+resource "netbox_manufacturer" "test" {
+  name = "my-manufacturer"
+}
+
+resource "netbox_device_type" "patch_panel" {
+  model           = "patch-panel"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_rear_port_template" "rear" {
+  name           = "rear"
+  type           = "mpo"
+  positions      = 12
+  device_type_id = netbox_device_type.patch_panel.id
+}
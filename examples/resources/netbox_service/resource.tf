@@ -9,3 +9,12 @@ resource "netbox_service" "ssh" {
   protocol           = "tcp"
   virtual_machine_id = data.netbox_virtual_machine.myvm.id
 }
+
+// Assumes Netbox already has a device with the ID 1 and an IP address with the ID 10 assigned to one of its interfaces
+resource "netbox_service" "dns" {
+  name           = "dns"
+  ports          = [53]
+  protocol       = "udp"
+  device_id      = 1
+  ip_address_ids = [10]
+}
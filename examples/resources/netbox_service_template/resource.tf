@@ -0,0 +1,11 @@
+resource "netbox_service_template" "https" {
+  name     = "https"
+  protocol = "tcp"
+  ports    = [443]
+}
+
+// Creates a service on a device from the template
+resource "netbox_service" "https" {
+  service_template_id = netbox_service_template.https.id
+  device_id           = 1
+}
@@ -2,3 +2,14 @@ resource "netbox_vrf" "cust_a_prod" {
   name = "cust-a-prod"
   tags = ["customer-a", "prod"]
 }
+
+resource "netbox_route_target" "cust_a" {
+  name = "65000:100"
+}
+
+resource "netbox_vrf" "cust_a_l3vpn" {
+  name              = "cust-a-l3vpn"
+  rd                = "65000:100"
+  import_target_ids = [netbox_route_target.cust_a.id]
+  export_target_ids = [netbox_route_target.cust_a.id]
+}
@@ -0,0 +1,235 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// netboxAPIError is returned by netboxRequest when Netbox answers with a non-2xx status code.
+// Its message mimics the errors returned by the generated go-netbox client.
+type netboxAPIError struct {
+	Method  string
+	Path    string
+	Code    int
+	Payload string
+}
+
+func (e *netboxAPIError) Error() string {
+	return fmt.Sprintf("[%s %s][%d] %s", e.Method, e.Path, e.Code, e.Payload)
+}
+
+// netboxRequest sends a request to an API endpoint that is not (or not correctly) covered
+// by the go-netbox client, e.g. list-body bulk operations. The transport of the given client is
+// reused, so authentication, custom headers and timeouts apply just like for generated calls.
+// body is JSON encoded if not nil, the response is JSON decoded into result if result is not nil.
+func netboxRequest(api *client.NetBoxAPI, method string, path string, query url.Values, body interface{}, result interface{}) error {
+	params := runtime.ClientRequestWriterFunc(func(r runtime.ClientRequest, reg strfmt.Registry) error {
+		for key, values := range query {
+			if err := r.SetQueryParam(key, values...); err != nil {
+				return err
+			}
+		}
+		if body != nil {
+			return r.SetBodyParam(body)
+		}
+		return nil
+	})
+
+	return netboxSubmit(api, method, path, "application/json", params, result)
+}
+
+// netboxUploadFiles uploads local files to the file fields of the object at path with a single multipart PATCH
+// request, e.g. the images of a device type. files maps the fields to the paths of the files, a field mapped to an
+// empty path is cleared. The updated object is JSON decoded into result if result is not nil.
+func netboxUploadFiles(api *client.NetBoxAPI, path string, files map[string]string, result interface{}) error {
+	opened := make(map[string]*os.File)
+	defer func() {
+		for _, file := range opened {
+			file.Close()
+		}
+	}()
+	for field, filePath := range files {
+		if filePath == "" {
+			continue
+		}
+		file, err := os.Open(filePath)
+		if err != nil {
+			return err
+		}
+		opened[field] = file
+	}
+
+	params := runtime.ClientRequestWriterFunc(func(r runtime.ClientRequest, reg strfmt.Registry) error {
+		for field := range files {
+			var err error
+			if file, ok := opened[field]; ok {
+				err = r.SetFileParam(field, file)
+			} else {
+				err = r.SetFormParam(field, "")
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return netboxSubmit(api, http.MethodPatch, path, runtime.MultipartFormMime, params, result)
+}
+
+// netboxSubmit sends a request written by params with the transport of the given client. The response is JSON
+// decoded into result if result is not nil, non-2xx answers are returned as netboxAPIError.
+func netboxSubmit(api *client.NetBoxAPI, method string, path string, mediaType string, params runtime.ClientRequestWriter, result interface{}) error {
+	reader := runtime.ClientResponseReaderFunc(func(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+		if response.Code() < 200 || response.Code() > 299 {
+			payload, _ := io.ReadAll(response.Body())
+			return nil, &netboxAPIError{
+				Method:  method,
+				Path:    path,
+				Code:    response.Code(),
+				Payload: string(payload),
+			}
+		}
+		if result != nil && response.Code() != http.StatusNoContent {
+			if err := consumer.Consume(response.Body(), result); err != nil && err != io.EOF {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+
+	_, err := api.Transport.Submit(&runtime.ClientOperation{
+		ID:                 method + " " + path,
+		Method:             method,
+		PathPattern:        path,
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{mediaType},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             reader,
+	})
+	return err
+}
+
+// isNetboxNotFound returns true if err is a 404 answer to a request made with netboxRequest.
+func isNetboxNotFound(err error) bool {
+	apiErr, ok := err.(*netboxAPIError)
+	return ok && apiErr.Code == http.StatusNotFound
+}
+
+// isNetboxConflict returns true if err is a 409 answer to a request made with netboxRequest. Netbox answers
+// allocation requests with a conflict if there is not enough space left.
+func isNetboxConflict(err error) bool {
+	apiErr, ok := err.(*netboxAPIError)
+	return ok && apiErr.Code == http.StatusConflict
+}
+
+// netboxStringChoice is a choice field with a string value as returned by Netbox, e.g. a status
+type netboxStringChoice struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+}
+
+// netboxIntChoice is a choice field with an integer value as returned by Netbox
+type netboxIntChoice struct {
+	Value int64  `json:"value"`
+	Label string `json:"label"`
+}
+
+// netboxNestedObject holds the ID of a related object as returned by Netbox
+type netboxNestedObject struct {
+	ID int64 `json:"id"`
+}
+
+func getIDsFromNetboxNestedObjects(objects []*netboxNestedObject) []int64 {
+	ids := make([]int64, 0, len(objects))
+	for _, object := range objects {
+		ids = append(ids, object.ID)
+	}
+	return ids
+}
+
+// netboxGetSingle lists the objects at path matching query and decodes the only result into result. kind is used
+// in error messages if there is not exactly one result.
+func netboxGetSingle(api *client.NetBoxAPI, path string, query url.Values, kind string, result interface{}) error {
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("limit", "2") // Limit of 2 is enough
+
+	var res struct {
+		Count   int64             `json:"count"`
+		Results []json.RawMessage `json:"results"`
+	}
+	err := netboxRequest(api, http.MethodGet, path, query, nil, &res)
+	if err != nil {
+		return err
+	}
+
+	if res.Count > int64(1) {
+		return fmt.Errorf("more than one %s returned, specify a more narrow filter", kind)
+	}
+	if res.Count == int64(0) {
+		return fmt.Errorf("no %s found matching filter", kind)
+	}
+	return json.Unmarshal(res.Results[0], result)
+}
+
+// netboxListAll lists all objects at path matching query, fetching as many pages as needed
+func netboxListAll[T any](api *client.NetBoxAPI, path string, query url.Values) ([]T, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+	limit := 1000
+	offset := 0
+	query.Set("limit", strconv.Itoa(limit))
+
+	var results []T
+	for {
+		query.Set("offset", strconv.Itoa(offset))
+		var res struct {
+			Count   int `json:"count"`
+			Results []T `json:"results"`
+		}
+		err := netboxRequest(api, http.MethodGet, path, query, nil, &res)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, res.Results...)
+
+		offset += len(res.Results)
+		if len(res.Results) == 0 || offset >= res.Count {
+			break
+		}
+	}
+	return results, nil
+}
+
+// netboxList lists the objects at path matching query. A limit of 0 lists all of them, fetching as many pages as
+// needed, otherwise only the first limit objects are returned.
+func netboxList[T any](api *client.NetBoxAPI, path string, query url.Values, limit int) ([]T, error) {
+	if limit == 0 {
+		return netboxListAll[T](api, path, query)
+	}
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("limit", strconv.Itoa(limit))
+
+	var res struct {
+		Results []T `json:"results"`
+	}
+	err := netboxRequest(api, http.MethodGet, path, query, nil, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res.Results, nil
+}
@@ -0,0 +1,49 @@
+package netbox
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetboxUploadFiles(t *testing.T) {
+	image, err := os.ReadFile("testdata/device_type_front.png")
+	assert.NoError(t, err)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/api/dcim/device-types/1/", r.URL.Path)
+		assert.NoError(t, r.ParseMultipartForm(1<<20))
+
+		file, _, err := r.FormFile("front_image")
+		assert.NoError(t, err)
+		uploaded, _ := io.ReadAll(file)
+		assert.Equal(t, image, uploaded)
+
+		// The rear image is cleared with an empty value
+		assert.Equal(t, []string{""}, r.MultipartForm.Value["rear_image"])
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 1, "front_image": "http://netbox/media/devicetype-images/front.png", "rear_image": null}`))
+	}))
+	defer ts.Close()
+
+	config := Config{APIToken: "07b12b765127747e4afd56cb531b7bf9c61f3c30", ServerURL: ts.URL}
+	api, err := config.Client()
+	assert.NoError(t, err)
+
+	var res map[string]interface{}
+	err = netboxUploadFiles(api, "/dcim/device-types/1/", map[string]string{
+		"front_image": "testdata/device_type_front.png",
+		"rear_image":  "",
+	}, &res)
+	assert.NoError(t, err)
+	assert.Equal(t, "http://netbox/media/devicetype-images/front.png", res["front_image"])
+
+	err = netboxUploadFiles(api, "/dcim/device-types/1/", map[string]string{"front_image": "testdata/missing.png"}, nil)
+	assert.True(t, os.IsNotExist(err))
+}
@@ -3,6 +3,7 @@ package netbox
 import (
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	netboxclient "github.com/fbreckle/go-netbox/netbox/client"
@@ -19,6 +20,27 @@ type Config struct {
 	Headers                     map[string]interface{}
 	RequestTimeout              int
 	StripTrailingSlashesFromURL bool
+	IgnoredCustomFields         []string
+	AutoCreateTags              bool
+	StrictTags                  bool
+}
+
+// clientOptions holds provider settings that change how resources talk to Netbox.
+// Resources only get hold of the go-netbox client, so the options are registered per client.
+type clientOptions struct {
+	IgnoredCustomFields map[string]bool
+	AutoCreateTags      bool
+	StrictTags          bool
+}
+
+var clientOptionsRegistry sync.Map
+
+// getClientOptions returns the options the given client was created with.
+func getClientOptions(api *netboxclient.NetBoxAPI) *clientOptions {
+	if opts, ok := clientOptionsRegistry.Load(api); ok {
+		return opts.(*clientOptions)
+	}
+	return &clientOptions{}
 }
 
 // customHeaderTransport is a transport that adds the specified headers on
@@ -83,6 +105,16 @@ func (cfg *Config) Client() (*netboxclient.NetBoxAPI, error) {
 	transport.SetLogger(log.StandardLogger())
 	netboxClient := netboxclient.New(transport, nil)
 
+	opts := &clientOptions{
+		IgnoredCustomFields: make(map[string]bool),
+		AutoCreateTags:      cfg.AutoCreateTags,
+		StrictTags:          cfg.StrictTags,
+	}
+	for _, name := range cfg.IgnoredCustomFields {
+		opts.IgnoredCustomFields[name] = true
+	}
+	clientOptionsRegistry.Store(netboxClient, opts)
+
 	return netboxClient, nil
 }
 
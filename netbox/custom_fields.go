@@ -1,10 +1,23 @@
 package netbox
 
 import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"sync"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/extras"
+	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const customFieldsKey = "custom_fields"
+const customFieldsModeKey = "custom_fields_mode"
+
+var resourceCustomFieldsModeOptions = []string{"authoritative", "merge"}
 
 var customFieldsSchema = &schema.Schema{
 	Type:     schema.TypeMap,
@@ -14,12 +27,298 @@ var customFieldsSchema = &schema.Schema{
 		Type:    schema.TypeString,
 		Default: nil,
 	},
+	DiffSuppressFunc: customFieldsDiffSuppress,
+	Description: "Custom field values are always given as strings. They are converted according to the type of the custom field in Netbox: " +
+		"`integer`, `decimal` and `boolean` values are given as their string representation (e.g. `\"42\"` or `\"true\"`), " +
+		"`json` and `multiselect` values as JSON (e.g. via `jsonencode`), `object` values as the ID of the referenced object " +
+		"and `multiobject` values as a JSON list of IDs (e.g. `\"[1,2]\"`).",
+}
+
+var customFieldsModeSchema = &schema.Schema{
+	Type:         schema.TypeString,
+	Optional:     true,
+	Default:      "authoritative",
+	ValidateFunc: validation.StringInSlice(resourceCustomFieldsModeOptions, false),
+	Description: buildValidValueDescription(resourceCustomFieldsModeOptions) + ". In `authoritative` mode, all custom fields of the object are managed and values that are not given in `custom_fields` are removed. " +
+		"In `merge` mode, only the custom fields given in `custom_fields` are managed, all other custom fields are neither compared nor changed",
+}
+
+// customFieldTypes caches the type of every custom field (by name) per Netbox client,
+// so we do not have to query the custom field definitions for every resource.
+var customFieldTypes = struct {
+	sync.Mutex
+	types map[*client.NetBoxAPI]map[string]string
+}{
+	types: make(map[*client.NetBoxAPI]map[string]string),
+}
+
+// getCustomFieldTypes returns the types of the given custom fields. The definitions are
+// fetched from Netbox if any of the custom fields is not known yet.
+func getCustomFieldTypes(api *client.NetBoxAPI, names []string) (map[string]string, error) {
+	customFieldTypes.Lock()
+	defer customFieldTypes.Unlock()
+
+	types, ok := customFieldTypes.types[api]
+	for _, name := range names {
+		if _, known := types[name]; !known {
+			ok = false
+			break
+		}
+	}
+	if ok {
+		return types, nil
+	}
+
+	types = make(map[string]string)
+	limit := int64(1000)
+	offset := int64(0)
+	for {
+		params := extras.NewExtrasCustomFieldsListParams()
+		params.Limit = &limit
+		params.Offset = &offset
+
+		res, err := api.Extras.ExtrasCustomFieldsList(params, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cf := range res.GetPayload().Results {
+			if cf.Name != nil && cf.Type != nil && cf.Type.Value != nil {
+				types[*cf.Name] = *cf.Type.Value
+			}
+		}
+
+		offset += int64(len(res.GetPayload().Results))
+		if len(res.GetPayload().Results) == 0 || offset >= *res.GetPayload().Count {
+			break
+		}
+	}
+
+	customFieldTypes.types[api] = types
+	return types, nil
+}
+
+// getCustomFieldsForAPI converts the string values of the custom_fields attribute to the
+// values Netbox expects for the respective custom field types. If the custom field
+// definitions cannot be retrieved or a value cannot be converted, the string value is sent
+// as-is and Netbox will report the invalid value.
+// In authoritative mode, custom fields that were removed from the attribute are cleared.
+// Custom fields in the provider's ignored_custom_fields are never sent.
+func getCustomFieldsForAPI(api *client.NetBoxAPI, d *schema.ResourceData) map[string]interface{} {
+	ignored := getClientOptions(api).IgnoredCustomFields
+	cfm, _ := d.Get(customFieldsKey).(map[string]interface{})
+
+	names := make([]string, 0, len(cfm))
+	for name := range cfm {
+		names = append(names, name)
+	}
+	var types map[string]string
+	if len(names) > 0 {
+		types, _ = getCustomFieldTypes(api, names)
+	}
+
+	ret := make(map[string]interface{}, len(cfm))
+	for name, value := range cfm {
+		if ignored[name] {
+			continue
+		}
+		strValue, _ := value.(string)
+		converted, err := customFieldValueToAPI(types[name], strValue)
+		if err != nil {
+			converted = strValue
+		}
+		ret[name] = converted
+	}
+
+	// Netbox keeps the values of custom fields that are not sent, so in authoritative mode
+	// removed ones have to be cleared explicitly
+	if mode, _ := d.Get(customFieldsModeKey).(string); mode != "merge" && d.HasChange(customFieldsKey) {
+		old, _ := d.GetChange(customFieldsKey)
+		for name := range old.(map[string]interface{}) {
+			if _, ok := cfm[name]; !ok && !ignored[name] {
+				ret[name] = nil
+			}
+		}
+	}
+
+	if len(ret) == 0 {
+		return nil
+	}
+	return ret
 }
 
-func getCustomFields(cf interface{}) map[string]interface{} {
+// getCustomFieldsForState returns the custom fields returned by Netbox that are managed by the resource,
+// according to its custom_fields_mode and the provider's ignored_custom_fields.
+func getCustomFieldsForState(api *client.NetBoxAPI, d *schema.ResourceData, cf interface{}) map[string]interface{} {
+	ignored := getClientOptions(api).IgnoredCustomFields
+	mode, _ := d.Get(customFieldsModeKey).(string)
+	if mode == "" {
+		// Imported resources have no mode yet
+		mode = "authoritative"
+		d.Set(customFieldsModeKey, mode)
+	}
+	merge := mode == "merge"
+	known, _ := d.Get(customFieldsKey).(map[string]interface{})
+
+	ret := make(map[string]interface{})
+	for name, value := range getCustomFields(api, cf) {
+		if ignored[name] {
+			continue
+		}
+		if _, ok := known[name]; merge && !ok {
+			continue
+		}
+		ret[name] = value
+	}
+	return ret
+}
+
+// getCustomFields converts the custom fields returned by Netbox to the string map used by the
+// custom_fields attribute. Custom fields without a value are omitted.
+func getCustomFields(api *client.NetBoxAPI, cf interface{}) map[string]interface{} {
 	cfm, ok := cf.(map[string]interface{})
 	if !ok || len(cfm) == 0 {
 		return nil
 	}
-	return cfm
+
+	var names []string
+	for name, value := range cfm {
+		if value != nil {
+			names = append(names, name)
+		}
+	}
+	var types map[string]string
+	if len(names) > 0 {
+		types, _ = getCustomFieldTypes(api, names)
+	}
+
+	ret := make(map[string]interface{}, len(cfm))
+	for name, value := range cfm {
+		if value == nil {
+			continue
+		}
+		converted, err := customFieldValueFromAPI(types[name], value)
+		if err != nil {
+			continue
+		}
+		ret[name] = converted
+	}
+	if len(ret) == 0 {
+		return nil
+	}
+	return ret
+}
+
+// customFieldsDiffSuppress suppresses differences between numerically equal custom field values, as Netbox
+// normalizes decimals, e.g. "1.50" is returned as "1.5"
+func customFieldsDiffSuppress(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	oldNumber, ok := new(big.Rat).SetString(oldValue)
+	if !ok {
+		return false
+	}
+	newNumber, ok := new(big.Rat).SetString(newValue)
+	if !ok {
+		return false
+	}
+	return oldNumber.Cmp(newNumber) == 0
+}
+
+// customFieldValueToAPI converts the string representation of a custom field value
+// to the value Netbox expects for the given custom field type.
+func customFieldValueToAPI(cfType string, value string) (interface{}, error) {
+	switch cfType {
+	case models.CustomFieldTypeValueText, models.CustomFieldTypeValueLongtext, "":
+		return value, nil
+	}
+
+	// An empty string clears all other custom field types
+	if value == "" {
+		return nil, nil
+	}
+
+	switch cfType {
+	case models.CustomFieldTypeValueInteger, models.CustomFieldTypeValueObject:
+		return strconv.ParseInt(value, 10, 64)
+	case models.CustomFieldTypeValueDecimal:
+		return strconv.ParseFloat(value, 64)
+	case models.CustomFieldTypeValueBoolean:
+		return strconv.ParseBool(value)
+	case models.CustomFieldTypeValueJSON:
+		var decoded interface{}
+		err := json.Unmarshal([]byte(value), &decoded)
+		return decoded, err
+	case models.CustomFieldTypeValueMultiselect:
+		var decoded []string
+		err := json.Unmarshal([]byte(value), &decoded)
+		return decoded, err
+	case models.CustomFieldTypeValueMultiobject:
+		var decoded []int64
+		err := json.Unmarshal([]byte(value), &decoded)
+		return decoded, err
+	default:
+		// date, datetime, url, select and all types we do not know about
+		return value, nil
+	}
+}
+
+// customFieldValueFromAPI converts a custom field value returned by Netbox to its string representation.
+// If the custom field type is unknown, the representation is derived from the value itself.
+func customFieldValueFromAPI(cfType string, value interface{}) (string, error) {
+	switch cfType {
+	case models.CustomFieldTypeValueObject:
+		if obj, ok := value.(map[string]interface{}); ok {
+			return customFieldValueFromAPI("", obj["id"])
+		}
+	case models.CustomFieldTypeValueMultiobject:
+		if objs, ok := value.([]interface{}); ok {
+			ids := make([]json.RawMessage, 0, len(objs))
+			for _, obj := range objs {
+				if objMap, ok := obj.(map[string]interface{}); ok {
+					obj = objMap["id"]
+				}
+				id, err := customFieldValueFromAPI("", obj)
+				if err != nil {
+					return "", err
+				}
+				ids = append(ids, json.RawMessage(id))
+			}
+			encoded, err := json.Marshal(ids)
+			return string(encoded), err
+		}
+	case models.CustomFieldTypeValueDecimal:
+		// Netbox may return decimals as strings with trailing zeros
+		if str, ok := value.(string); ok {
+			f, err := strconv.ParseFloat(str, 64)
+			if err != nil {
+				return "", err
+			}
+			return strconv.FormatFloat(f, 'f', -1, 64), nil
+		}
+	case models.CustomFieldTypeValueJSON, models.CustomFieldTypeValueMultiselect:
+		encoded, err := json.Marshal(value)
+		return string(encoded), err
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case json.Number:
+		return v.String(), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case int:
+		return strconv.Itoa(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case nil:
+		return "", nil
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("could not convert custom field value %v: %w", v, err)
+		}
+		return string(encoded), nil
+	}
 }
@@ -0,0 +1,162 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomFieldValueToAPI(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		cfType   string
+		value    string
+		expected interface{}
+	}{
+		{name: "Text", cfType: "text", value: "foo", expected: "foo"},
+		{name: "TextEmpty", cfType: "text", value: "", expected: ""},
+		{name: "Unknown", cfType: "", value: "42", expected: "42"},
+		{name: "Integer", cfType: "integer", value: "42", expected: int64(42)},
+		{name: "IntegerEmpty", cfType: "integer", value: "", expected: nil},
+		{name: "Decimal", cfType: "decimal", value: "1.5", expected: 1.5},
+		{name: "Boolean", cfType: "boolean", value: "true", expected: true},
+		{name: "Date", cfType: "date", value: "2024-01-31", expected: "2024-01-31"},
+		{name: "JSON", cfType: "json", value: `{"a":[1,2]}`, expected: map[string]interface{}{"a": []interface{}{float64(1), float64(2)}}},
+		{name: "Multiselect", cfType: "multiselect", value: `["a","b"]`, expected: []string{"a", "b"}},
+		{name: "Object", cfType: "object", value: "7", expected: int64(7)},
+		{name: "Multiobject", cfType: "multiobject", value: "[1,2]", expected: []int64{1, 2}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := customFieldValueToAPI(tt.cfType, tt.value)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestCustomFieldValueToAPIInvalid(t *testing.T) {
+	_, err := customFieldValueToAPI("integer", "foo")
+	assert.Error(t, err)
+
+	_, err = customFieldValueToAPI("boolean", "yes please")
+	assert.Error(t, err)
+
+	_, err = customFieldValueToAPI("multiobject", "1,2")
+	assert.Error(t, err)
+}
+
+func TestCustomFieldValueFromAPI(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		cfType   string
+		value    interface{}
+		expected string
+	}{
+		{name: "Text", cfType: "text", value: "foo", expected: "foo"},
+		{name: "Integer", cfType: "integer", value: json.Number("42"), expected: "42"},
+		{name: "IntegerFloat", cfType: "integer", value: float64(42), expected: "42"},
+		{name: "Decimal", cfType: "decimal", value: "1.50", expected: "1.5"},
+		{name: "Boolean", cfType: "boolean", value: true, expected: "true"},
+		{name: "JSON", cfType: "json", value: map[string]interface{}{"b": json.Number("1"), "a": "x"}, expected: `{"a":"x","b":1}`},
+		{name: "Multiselect", cfType: "multiselect", value: []interface{}{"a", "b"}, expected: `["a","b"]`},
+		{name: "Object", cfType: "object", value: map[string]interface{}{"id": json.Number("7"), "display": "foo"}, expected: "7"},
+		{name: "Multiobject", cfType: "multiobject", value: []interface{}{
+			map[string]interface{}{"id": json.Number("1")},
+			map[string]interface{}{"id": json.Number("2")},
+		}, expected: "[1,2]"},
+		{name: "UnknownBoolean", cfType: "", value: false, expected: "false"},
+		{name: "UnknownList", cfType: "", value: []interface{}{json.Number("1"), "a"}, expected: `[1,"a"]`},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := customFieldValueFromAPI(tt.cfType, tt.value)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func testCustomFieldsResourceData(t *testing.T, mode string, cf map[string]interface{}) *schema.ResourceData {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			customFieldsKey:     customFieldsSchema,
+			customFieldsModeKey: customFieldsModeSchema,
+		},
+	}
+	return schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		customFieldsKey:     cf,
+		customFieldsModeKey: mode,
+	})
+}
+
+func TestCustomFieldsDiffSuppress(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			customFieldsKey: customFieldsSchema,
+		},
+	}
+	state := &terraform.InstanceState{
+		ID: "1",
+		Attributes: map[string]string{
+			"id":                  "1",
+			"custom_fields.%":     "2",
+			"custom_fields.price": "1.5",
+			"custom_fields.name":  "1.5",
+		},
+	}
+
+	for _, tt := range []struct {
+		name    string
+		config  map[string]interface{}
+		changed bool
+	}{
+		{name: "TrailingZero", config: map[string]interface{}{"price": "1.50", "name": "1.5"}},
+		{name: "Changed", config: map[string]interface{}{"price": "1.51", "name": "1.5"}, changed: true},
+		{name: "Text", config: map[string]interface{}{"price": "1.5", "name": "foo"}, changed: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{customFieldsKey: tt.config})
+			diff, err := r.SimpleDiff(context.Background(), state, config, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.changed, diff != nil && len(diff.Attributes) > 0, "%v", diff)
+		})
+	}
+}
+
+func TestGetCustomFieldsForAPI(t *testing.T) {
+	api := &client.NetBoxAPI{}
+	customFieldTypes.types[api] = map[string]string{"count": "integer", "owner": "text", "last_seen": "date"}
+	clientOptionsRegistry.Store(api, &clientOptions{IgnoredCustomFields: map[string]bool{"last_seen": true}})
+
+	d := testCustomFieldsResourceData(t, "authoritative", map[string]interface{}{
+		"count":     "3",
+		"owner":     "me",
+		"last_seen": "2024-01-01",
+	})
+
+	assert.Equal(t, map[string]interface{}{"count": int64(3), "owner": "me"}, getCustomFieldsForAPI(api, d))
+}
+
+func TestGetCustomFieldsForState(t *testing.T) {
+	api := &client.NetBoxAPI{}
+	customFieldTypes.types[api] = map[string]string{"count": "integer", "owner": "text", "last_seen": "date", "os": "text"}
+	clientOptionsRegistry.Store(api, &clientOptions{IgnoredCustomFields: map[string]bool{"last_seen": true}})
+
+	fromAPI := map[string]interface{}{
+		"count":     json.Number("3"),
+		"owner":     "me",
+		"last_seen": "2024-01-01",
+		"os":        "linux",
+		"unset":     nil,
+	}
+
+	d := testCustomFieldsResourceData(t, "authoritative", map[string]interface{}{"count": "3"})
+	assert.Equal(t, map[string]interface{}{"count": "3", "owner": "me", "os": "linux"}, getCustomFieldsForState(api, d, fromAPI))
+
+	d = testCustomFieldsResourceData(t, "merge", map[string]interface{}{"count": "3"})
+	assert.Equal(t, map[string]interface{}{"count": "3"}, getCustomFieldsForState(api, d, fromAPI))
+}
@@ -0,0 +1,104 @@
+package netbox
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxAggregate() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxAggregateRead,
+		Description: `:meta:subcategory:IP Address Management (IPAM):`,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "prefix"},
+			},
+			"prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validatePrefix,
+				AtLeastOneOf: []string{"id", "prefix"},
+			},
+			"rir_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"date_added": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			customFieldsKey: customFieldsSchema,
+			"tags":          tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxAggregateRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	params := ipam.NewIpamAggregatesListParams()
+
+	limit := int64(2) // Limit of 2 is enough
+	params.Limit = &limit
+
+	if id, ok := d.Get("id").(int); ok && id != 0 {
+		params.ID = strToPtr(strconv.Itoa(id))
+	}
+	if prefix, ok := d.Get("prefix").(string); ok && prefix != "" {
+		params.Prefix = &prefix
+	}
+
+	res, err := api.Ipam.IpamAggregatesList(params, nil)
+	if err != nil {
+		return err
+	}
+
+	if *res.GetPayload().Count > int64(1) {
+		return errors.New("more than one aggregate returned, specify a more narrow filter")
+	}
+	if *res.GetPayload().Count == int64(0) {
+		return errors.New("no aggregate found matching filter")
+	}
+	result := res.GetPayload().Results[0]
+	d.SetId(strconv.FormatInt(result.ID, 10))
+	d.Set("id", result.ID)
+	d.Set("prefix", result.Prefix)
+	if result.Rir != nil {
+		d.Set("rir_id", result.Rir.ID)
+	}
+	if result.Tenant != nil {
+		d.Set("tenant_id", result.Tenant.ID)
+	}
+	if result.DateAdded != nil {
+		d.Set("date_added", result.DateAdded.String())
+	}
+	d.Set("description", result.Description)
+	d.Set("comments", result.Comments)
+
+	cf := getCustomFields(api, result.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+	d.Set("tags", getTagListFromNestedTagList(result.Tags))
+	return nil
+}
@@ -0,0 +1,54 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxAggregateDataSource_basic(t *testing.T) {
+	testPrefix := "198.18.1.0/24"
+	testSlug := "agg_ds"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_rir" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_aggregate" "test" {
+  prefix      = "%[2]s"
+  rir_id      = netbox_rir.test.id
+  date_added  = "2020-01-15"
+  description = "%[1]s_description"
+  tags        = [netbox_tag.test.name]
+}
+
+data "netbox_aggregate" "by_prefix" {
+  prefix = netbox_aggregate.test.prefix
+}
+
+data "netbox_aggregate" "by_id" {
+  id = netbox_aggregate.test.id
+}`, testName, testPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_aggregate.by_prefix", "id", "netbox_aggregate.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_aggregate.by_prefix", "rir_id", "netbox_rir.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_aggregate.by_prefix", "date_added", "2020-01-15"),
+					resource.TestCheckResourceAttr("data.netbox_aggregate.by_prefix", "description", testName+"_description"),
+					resource.TestCheckResourceAttr("data.netbox_aggregate.by_prefix", "tags.#", "1"),
+					resource.TestCheckResourceAttr("data.netbox_aggregate.by_id", "prefix", testPrefix),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,143 @@
+package netbox
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/exp/slices"
+)
+
+// dataSourceNetboxAggregatesFilterOptions are the filters passed on to Netbox as they are
+var dataSourceNetboxAggregatesFilterOptions = []string{
+	"id", "prefix", "family", "description", "date_added", "date_added__gte", "date_added__lte", "rir", "rir__n",
+	"rir_id", "rir_id__n", "tenant", "tenant__n", "tenant_id", "tenant_id__n", "tag", "tag__n",
+}
+
+func dataSourceNetboxAggregates() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxAggregatesRead,
+		Description: `:meta:subcategory:IP Address Management (IPAM):`,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: buildValidValueDescription(dataSourceNetboxAggregatesFilterOptions),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Default:          0,
+			},
+			"aggregates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"family": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"rir_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"date_added": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tagsSchemaRead,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxAggregatesRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	query := url.Values{}
+
+	if filter, ok := d.GetOk("filter"); ok {
+		var filterParams = filter.(*schema.Set)
+		for _, f := range filterParams.List() {
+			k := f.(map[string]interface{})["name"].(string)
+			v := f.(map[string]interface{})["value"].(string)
+			if !slices.Contains(dataSourceNetboxAggregatesFilterOptions, k) {
+				return fmt.Errorf("'%s' is not a supported filter parameter", k)
+			}
+			query.Add(k, v)
+		}
+	}
+
+	aggregates, err := netboxList[*models.Aggregate](api, "/ipam/aggregates/", query, d.Get("limit").(int))
+	if err != nil {
+		return err
+	}
+
+	if len(aggregates) == 0 {
+		return errors.New("no result")
+	}
+
+	var s []map[string]interface{}
+	for _, v := range aggregates {
+		var mapping = make(map[string]interface{})
+
+		mapping["id"] = v.ID
+		mapping["prefix"] = v.Prefix
+		if v.Family != nil && v.Family.Value != nil {
+			mapping["family"] = *v.Family.Value
+		}
+		if v.Rir != nil {
+			mapping["rir_id"] = v.Rir.ID
+		}
+		if v.Tenant != nil {
+			mapping["tenant_id"] = v.Tenant.ID
+		}
+		if v.DateAdded != nil {
+			mapping["date_added"] = v.DateAdded.String()
+		}
+		mapping["description"] = v.Description
+		mapping["tags"] = getTagListFromNestedTagList(v.Tags)
+
+		s = append(s, mapping)
+	}
+
+	d.SetId(id.UniqueId())
+	return d.Set("aggregates", s)
+}
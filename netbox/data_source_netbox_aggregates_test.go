@@ -0,0 +1,75 @@
+package netbox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxAggregatesDataSource_basic(t *testing.T) {
+	testSlug := "aggs_ds"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_rir" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_aggregate" "test_0" {
+  prefix = "198.18.2.0/24"
+  rir_id = netbox_rir.test.id
+}
+
+resource "netbox_aggregate" "test_1" {
+  prefix     = "198.18.3.0/24"
+  rir_id     = netbox_rir.test.id
+  date_added = "2022-06-30"
+}
+
+data "netbox_aggregates" "test" {
+  filter {
+    name  = "rir_id"
+    value = netbox_rir.test.id
+  }
+  depends_on = [netbox_aggregate.test_0, netbox_aggregate.test_1]
+}
+
+data "netbox_aggregates" "dated" {
+  filter {
+    name  = "rir_id"
+    value = netbox_rir.test.id
+  }
+  filter {
+    name  = "date_added__gte"
+    value = "2022-01-01"
+  }
+  depends_on = [netbox_aggregate.test_0, netbox_aggregate.test_1]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_aggregates.test", "aggregates.#", "2"),
+					resource.TestCheckResourceAttr("data.netbox_aggregates.test", "aggregates.0.family", "4"),
+					resource.TestCheckResourceAttrPair("data.netbox_aggregates.test", "aggregates.0.rir_id", "netbox_rir.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_aggregates.dated", "aggregates.#", "1"),
+					resource.TestCheckResourceAttr("data.netbox_aggregates.dated", "aggregates.0.prefix", "198.18.3.0/24"),
+					resource.TestCheckResourceAttr("data.netbox_aggregates.dated", "aggregates.0.date_added", "2022-06-30"),
+				),
+			},
+			{
+				Config: `
+data "netbox_aggregates" "test" {
+  filter {
+    name  = "foo"
+    value = "bar"
+  }
+}`,
+				ExpectError: regexp.MustCompile("'foo' is not a supported filter parameter"),
+			},
+		},
+	})
+}
@@ -0,0 +1,101 @@
+package netbox
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxAsnRange() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxAsnRangeRead,
+		Description: `:meta:subcategory:IP Address Management (IPAM):`,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"name", "slug"},
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"name", "slug"},
+			},
+			"rir_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"start": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"end": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxAsnRangeRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	query := url.Values{"limit": []string{"2"}} // Limit of 2 is enough
+	if name, ok := d.Get("name").(string); ok && name != "" {
+		query.Set("name", name)
+	}
+	if slug, ok := d.Get("slug").(string); ok && slug != "" {
+		query.Set("slug", slug)
+	}
+
+	var res struct {
+		Count   int64       `json:"count"`
+		Results []*asnRange `json:"results"`
+	}
+	err := netboxRequest(api, http.MethodGet, "/ipam/asn-ranges/", query, nil, &res)
+	if err != nil {
+		return err
+	}
+
+	if res.Count > int64(1) {
+		return errors.New("more than one asn range returned, specify a more narrow filter")
+	}
+	if res.Count == int64(0) {
+		return errors.New("no asn range found matching filter")
+	}
+	result := res.Results[0]
+	d.SetId(strconv.FormatInt(result.ID, 10))
+	d.Set("id", result.ID)
+	d.Set("name", result.Name)
+	d.Set("slug", result.Slug)
+	if result.Rir != nil {
+		d.Set("rir_id", result.Rir.ID)
+	}
+	d.Set("start", result.Start)
+	d.Set("end", result.End)
+	if result.Tenant != nil {
+		d.Set("tenant_id", result.Tenant.ID)
+	}
+	d.Set("description", result.Description)
+	d.Set("tags", getTagListFromNestedTagList(result.Tags))
+	return nil
+}
@@ -0,0 +1,50 @@
+package netbox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxAsnRangeDataSource_basic(t *testing.T) {
+	testName := testAccGetTestName("asn_range_ds_basic")
+	setUp := fmt.Sprintf(`
+resource "netbox_rir" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_asn_range" "test" {
+  name   = "%[1]s"
+  rir_id = netbox_rir.test.id
+  start  = 4200003000
+  end    = 4200003009
+}`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: setUp + `
+data "netbox_asn_range" "test" {
+  name = "nonexistent-asn-range"
+}`,
+				ExpectError: regexp.MustCompile("no asn range found matching filter"),
+			},
+			{
+				Config: setUp + `
+data "netbox_asn_range" "test" {
+  slug = netbox_asn_range.test.slug
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_asn_range.test", "id", "netbox_asn_range.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_asn_range.test", "rir_id", "netbox_rir.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_asn_range.test", "name", testName),
+					resource.TestCheckResourceAttr("data.netbox_asn_range.test", "start", "4200003000"),
+					resource.TestCheckResourceAttr("data.netbox_asn_range.test", "end", "4200003009"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,147 @@
+package netbox
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetboxAvailableIPAddresses() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"prefix_id": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ExactlyOneOf: []string{"prefix_id", "ip_range_id"},
+		},
+		"ip_range_id": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ExactlyOneOf: []string{"prefix_id", "ip_range_id"},
+		},
+		"limit": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          100,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 1000)),
+			Description:      "The maximum number of IP addresses to return in `ip_addresses`. Netbox never returns more than its `MAX_PAGE_SIZE`. Does not affect the capacity attributes.",
+		},
+		"ip_addresses": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The available IP addresses in CIDR notation, in ascending order.",
+		},
+	}
+	for k, v := range capacitySchema("usable IP addresses") {
+		s[k] = v
+	}
+
+	return &schema.Resource{
+		Read: dataSourceNetboxAvailableIPAddressesRead,
+		Description: `:meta:subcategory:IP Address Management (IPAM):Lists the IP addresses of a prefix or IP range that are not used by an IP address yet, along with the capacity of the prefix or IP range.
+
+Like in Netbox, the network and broadcast addresses of IPv4 prefixes and the Subnet-Router anycast address of IPv6 prefixes are not usable, unless the prefix is a pool.`,
+		Schema: s,
+	}
+}
+
+func dataSourceNetboxAvailableIPAddressesRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	limit := d.Get("limit").(int)
+
+	var path string
+	var parents []netip.Prefix
+	var vrf *models.NestedVRF
+	var size *big.Int
+	if prefixID, ok := d.GetOk("prefix_id"); ok {
+		res, err := api.Ipam.IpamPrefixesRead(ipam.NewIpamPrefixesReadParams().WithID(int64(prefixID.(int))), nil)
+		if err != nil {
+			return err
+		}
+		prefix, err := netip.ParsePrefix(*res.GetPayload().Prefix)
+		if err != nil {
+			return err
+		}
+		path = fmt.Sprintf("/ipam/prefixes/%d/available-ips/", prefixID)
+		parents = []netip.Prefix{prefix}
+		vrf = res.GetPayload().Vrf
+		size = prefixSize(prefix)
+		if !res.GetPayload().IsPool && prefix.Bits() < prefix.Addr().BitLen()-1 {
+			if prefix.Addr().Is4() {
+				size.Sub(size, big.NewInt(2))
+			} else {
+				size.Sub(size, big.NewInt(1))
+			}
+		}
+		d.SetId(fmt.Sprintf("prefix/%d", prefixID))
+	} else {
+		rangeID := d.Get("ip_range_id").(int)
+		res, err := api.Ipam.IpamIPRangesRead(ipam.NewIpamIPRangesReadParams().WithID(int64(rangeID)), nil)
+		if err != nil {
+			return err
+		}
+		start, err := netip.ParsePrefix(*res.GetPayload().StartAddress)
+		if err != nil {
+			return err
+		}
+		end, err := netip.ParsePrefix(*res.GetPayload().EndAddress)
+		if err != nil {
+			return err
+		}
+		path = fmt.Sprintf("/ipam/ip-ranges/%d/available-ips/", rangeID)
+		parents = ipRangeToPrefixes(start.Addr(), end.Addr())
+		vrf = res.GetPayload().Vrf
+		size = ipRangeSize(start.Addr(), end.Addr())
+		d.SetId(fmt.Sprintf("ip-range/%d", rangeID))
+	}
+
+	var available []*models.AvailableIP
+	err := netboxRequest(api, http.MethodGet, path, url.Values{"limit": []string{strconv.Itoa(limit)}}, nil, &available)
+	if err != nil {
+		return err
+	}
+	addresses := make([]string, 0, len(available))
+	for _, ip := range available {
+		addresses = append(addresses, ip.Address)
+	}
+
+	used, err := countIPAddressesInPrefixes(api, parents, vrf)
+	if err != nil {
+		return err
+	}
+
+	d.Set("ip_addresses", addresses)
+	setCapacity(d, size, big.NewInt(used))
+	return nil
+}
+
+// countIPAddressesInPrefixes returns the number of IP addresses of the given VRF within any of the given prefixes
+func countIPAddressesInPrefixes(api *client.NetBoxAPI, prefixes []netip.Prefix, vrf *models.NestedVRF) (int64, error) {
+	query := url.Values{"limit": []string{"1"}}
+	for _, prefix := range prefixes {
+		query.Add("parent", prefix.String())
+	}
+	if vrf != nil {
+		query.Set("vrf_id", strconv.FormatInt(vrf.ID, 10))
+	} else {
+		query.Set("vrf_id", "null")
+	}
+
+	var res struct {
+		Count int64 `json:"count"`
+	}
+	err := netboxRequest(api, http.MethodGet, "/ipam/ip-addresses/", query, nil, &res)
+	if err != nil {
+		return 0, err
+	}
+	return res.Count, nil
+}
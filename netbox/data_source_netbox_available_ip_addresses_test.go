@@ -0,0 +1,64 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxAvailableIPAddressesDataSource_basic(t *testing.T) {
+	testName := testAccGetTestName("avail_ips_ds")
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_prefix" "test" {
+  prefix      = "10.38.0.0/29"
+  status      = "active"
+  description = "%[1]s"
+}
+
+resource "netbox_ip_range" "test" {
+  start_address = "10.38.1.10/24"
+  end_address   = "10.38.1.20/24"
+  description   = "%[1]s"
+}
+
+resource "netbox_ip_address" "prefix" {
+  ip_address = "10.38.0.1/29"
+  status     = "active"
+}
+
+resource "netbox_ip_address" "range" {
+  ip_address = "10.38.1.12/24"
+  status     = "active"
+}
+
+data "netbox_available_ip_addresses" "prefix" {
+  prefix_id  = netbox_prefix.test.id
+  limit      = 2
+  depends_on = [netbox_ip_address.prefix]
+}
+
+data "netbox_available_ip_addresses" "range" {
+  ip_range_id = netbox_ip_range.test.id
+  depends_on  = [netbox_ip_address.range]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_available_ip_addresses.prefix", "size", "6"),
+					resource.TestCheckResourceAttr("data.netbox_available_ip_addresses.prefix", "used", "1"),
+					resource.TestCheckResourceAttr("data.netbox_available_ip_addresses.prefix", "free", "5"),
+					resource.TestCheckResourceAttr("data.netbox_available_ip_addresses.prefix", "ip_addresses.#", "2"),
+					resource.TestCheckResourceAttr("data.netbox_available_ip_addresses.prefix", "ip_addresses.0", "10.38.0.2/29"),
+					resource.TestCheckResourceAttr("data.netbox_available_ip_addresses.range", "size", "11"),
+					resource.TestCheckResourceAttr("data.netbox_available_ip_addresses.range", "used", "1"),
+					resource.TestCheckResourceAttr("data.netbox_available_ip_addresses.range", "free", "10"),
+					resource.TestCheckResourceAttr("data.netbox_available_ip_addresses.range", "ip_addresses.#", "10"),
+				),
+			},
+		},
+	})
+}
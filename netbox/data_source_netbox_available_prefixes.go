@@ -0,0 +1,107 @@
+package netbox
+
+import (
+	"math/big"
+	"net/netip"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNetboxAvailablePrefixes() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"prefix_id": {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+		"prefix_length": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 128)),
+			Description:      "If set, the free space is split into prefixes of this length and `prefix_count` is the number of such prefixes that fit.",
+		},
+		"limit": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          100,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			Description:      "The maximum number of prefixes to return in `prefixes`. Does not affect `prefix_count` and the capacity attributes.",
+		},
+		"prefixes": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The available prefixes, in the order Netbox returns them. These are the largest free blocks, unless `prefix_length` is set.",
+		},
+		"prefix_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of available prefixes. Values that do not fit into a number are capped at the largest number.",
+		},
+	}
+	for k, v := range capacitySchema("addresses") {
+		s[k] = v
+	}
+
+	return &schema.Resource{
+		Read: dataSourceNetboxAvailablePrefixesRead,
+		Description: `:meta:subcategory:IP Address Management (IPAM):Lists the free space of a prefix, i.e. the space not covered by child prefixes, along with the capacity of the prefix.
+
+Use ` + "`prefix_length`" + ` to check whether a number of prefixes of a given length still fits, e.g. sixteen /28s into a /22.`,
+		Schema: s,
+	}
+}
+
+func dataSourceNetboxAvailablePrefixesRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	prefixID := int64(d.Get("prefix_id").(int))
+	limit := d.Get("limit").(int)
+
+	res, err := api.Ipam.IpamPrefixesRead(ipam.NewIpamPrefixesReadParams().WithID(prefixID), nil)
+	if err != nil {
+		return err
+	}
+	parent, err := netip.ParsePrefix(*res.GetPayload().Prefix)
+	if err != nil {
+		return err
+	}
+
+	availableRes, err := api.Ipam.IpamPrefixesAvailablePrefixesList(ipam.NewIpamPrefixesAvailablePrefixesListParams().WithID(prefixID), nil)
+	if err != nil {
+		return err
+	}
+
+	free := new(big.Int)
+	available := make([]netip.Prefix, 0, len(availableRes.GetPayload()))
+	for _, v := range availableRes.GetPayload() {
+		prefix, err := netip.ParsePrefix(v.Prefix)
+		if err != nil {
+			return err
+		}
+		available = append(available, prefix)
+		free.Add(free, prefixSize(prefix))
+	}
+
+	count := big.NewInt(int64(len(available)))
+	if length, ok := d.GetOk("prefix_length"); ok {
+		available, count = splitPrefixes(available, length.(int), limit)
+	}
+	if len(available) > limit {
+		available = available[:limit]
+	}
+
+	prefixes := make([]string, 0, len(available))
+	for _, prefix := range available {
+		prefixes = append(prefixes, prefix.String())
+	}
+
+	size := prefixSize(parent)
+	d.SetId(strconv.FormatInt(prefixID, 10))
+	d.Set("prefixes", prefixes)
+	d.Set("prefix_count", bigToInt(count))
+	setCapacity(d, size, new(big.Int).Sub(size, free))
+	return nil
+}
@@ -0,0 +1,56 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxAvailablePrefixesDataSource_capacity(t *testing.T) {
+	testName := testAccGetTestName("avail_prefixes_ds")
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_prefix" "parent" {
+  prefix      = "10.37.0.0/22"
+  status      = "container"
+  description = "%[1]s"
+}
+
+resource "netbox_prefix" "child" {
+  prefix      = "10.37.0.0/23"
+  status      = "active"
+  description = "%[1]s"
+}
+
+data "netbox_available_prefixes" "test" {
+  prefix_id     = netbox_prefix.parent.id
+  prefix_length = 28
+  limit         = 3
+  depends_on    = [netbox_prefix.child]
+}
+
+data "netbox_available_prefixes" "blocks" {
+  prefix_id  = netbox_prefix.parent.id
+  depends_on = [netbox_prefix.child]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_available_prefixes.test", "size", "1024"),
+					resource.TestCheckResourceAttr("data.netbox_available_prefixes.test", "used", "512"),
+					resource.TestCheckResourceAttr("data.netbox_available_prefixes.test", "free", "512"),
+					resource.TestCheckResourceAttr("data.netbox_available_prefixes.test", "utilization", "50"),
+					resource.TestCheckResourceAttr("data.netbox_available_prefixes.test", "prefix_count", "32"),
+					resource.TestCheckResourceAttr("data.netbox_available_prefixes.test", "prefixes.#", "3"),
+					resource.TestCheckResourceAttr("data.netbox_available_prefixes.test", "prefixes.0", "10.37.2.0/28"),
+					resource.TestCheckResourceAttr("data.netbox_available_prefixes.test", "prefixes.1", "10.37.2.16/28"),
+					resource.TestCheckResourceAttr("data.netbox_available_prefixes.blocks", "prefix_count", "1"),
+					resource.TestCheckResourceAttr("data.netbox_available_prefixes.blocks", "prefixes.0", "10.37.2.0/23"),
+				),
+			},
+		},
+	})
+}
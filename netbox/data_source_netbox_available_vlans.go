@@ -0,0 +1,67 @@
+package netbox
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// availableVlansMaxLimit is the largest number of VLAN IDs a VLAN group can have
+const availableVlansMaxLimit = 4094
+
+func dataSourceNetboxAvailableVlans() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxAvailableVlansRead,
+		Description: `:meta:subcategory:IP Address Management (IPAM):Lists the VLAN IDs of a VLAN group that are not used by a VLAN yet.`,
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, availableVlansMaxLimit)),
+				Description:      "The maximum number of VLAN IDs to return. Netbox never returns more than its `MAX_PAGE_SIZE`.",
+			},
+			"vids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The available VLAN IDs, in ascending order.",
+			},
+		},
+	}
+}
+
+func dataSourceNetboxAvailableVlansRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	groupID := d.Get("group_id").(int)
+
+	limit := availableVlansMaxLimit
+	if l, ok := d.GetOk("limit"); ok {
+		limit = l.(int)
+	}
+
+	// The client does not support limiting the results, which default to Netbox' page size
+	var available []*models.AvailableVLAN
+	query := url.Values{"limit": []string{strconv.Itoa(limit)}}
+	err := netboxRequest(api, "GET", fmt.Sprintf("/ipam/vlan-groups/%d/available-vlans/", groupID), query, nil, &available)
+	if err != nil {
+		return err
+	}
+
+	vids := make([]int64, 0, len(available))
+	for _, vlan := range available {
+		vids = append(vids, vlan.Vid)
+	}
+
+	d.SetId(strconv.Itoa(groupID))
+	d.Set("vids", vids)
+	return nil
+}
@@ -0,0 +1,395 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// dataSourceNetboxCableTracePaths maps the object types that can be traced to their API paths
+var dataSourceNetboxCableTracePaths = map[string]string{
+	"dcim.consoleport":       "/dcim/console-ports/",
+	"dcim.consoleserverport": "/dcim/console-server-ports/",
+	"dcim.frontport":         "/dcim/front-ports/",
+	"dcim.interface":         "/dcim/interfaces/",
+	"dcim.powerfeed":         "/dcim/power-feeds/",
+	"dcim.poweroutlet":       "/dcim/power-outlets/",
+	"dcim.powerport":         "/dcim/power-ports/",
+	"dcim.rearport":          "/dcim/rear-ports/",
+}
+
+// dataSourceNetboxCableTraceObjectTypes are the object types that can be traced, sorted
+var dataSourceNetboxCableTraceObjectTypes = func() []string {
+	objectTypes := maps.Keys(dataSourceNetboxCableTracePaths)
+	slices.Sort(objectTypes)
+	return objectTypes
+}()
+
+// dataSourceNetboxCableTracePassThroughTypes are the object types that are not path endpoints. Netbox does not trace
+// from them, but lists the paths running through them.
+var dataSourceNetboxCableTracePassThroughTypes = []string{
+	"dcim.frontport",
+	"dcim.rearport",
+}
+
+// cableTraceEndpointObjectTypes maps the API endpoints of everything a cable can be attached to to the object type,
+// so that the terminations of a trace can be given as generic objects
+var cableTraceEndpointObjectTypes = map[string]string{
+	"circuits/circuit-terminations": "circuits.circuittermination",
+	"circuits/provider-networks":    "circuits.providernetwork",
+	"dcim/console-ports":            "dcim.consoleport",
+	"dcim/console-server-ports":     "dcim.consoleserverport",
+	"dcim/front-ports":              "dcim.frontport",
+	"dcim/interfaces":               "dcim.interface",
+	"dcim/power-feeds":              "dcim.powerfeed",
+	"dcim/power-outlets":            "dcim.poweroutlet",
+	"dcim/power-ports":              "dcim.powerport",
+	"dcim/rear-ports":               "dcim.rearport",
+}
+
+// cableTraceTermination is a cable termination as returned by the trace endpoints of Netbox
+type cableTraceTermination struct {
+	ID      int64               `json:"id"`
+	URL     string              `json:"url"`
+	Name    string              `json:"name"`
+	Display string              `json:"display"`
+	Device  *netboxNestedObject `json:"device"`
+}
+
+// cableTraceCable is a cable as returned by the trace endpoints of Netbox
+type cableTraceCable struct {
+	ID    int64  `json:"id"`
+	Label string `json:"label"`
+}
+
+// cableTraceHop is one segment of a traced cable path, encoded by Netbox as a list of the near end terminations,
+// the cable (or null) and the far end terminations
+type cableTraceHop struct {
+	NearEnds []*cableTraceTermination
+	Cable    *cableTraceCable
+	FarEnds  []*cableTraceTermination
+}
+
+func (hop *cableTraceHop) UnmarshalJSON(data []byte) error {
+	var parts []json.RawMessage
+	if err := json.Unmarshal(data, &parts); err != nil {
+		return err
+	}
+	if len(parts) != 3 {
+		return fmt.Errorf("expected a cable trace hop to have 3 elements, got %d", len(parts))
+	}
+	if err := json.Unmarshal(parts[0], &hop.NearEnds); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(parts[1], &hop.Cable); err != nil {
+		return err
+	}
+	return json.Unmarshal(parts[2], &hop.FarEnds)
+}
+
+// cableTracePath is a cable path as returned by the paths endpoints of front and rear ports. The path is a list of
+// node lists, alternating between the near end terminations, the cable and the far end terminations of each hop.
+type cableTracePath struct {
+	Path       []json.RawMessage `json:"path"`
+	IsComplete bool              `json:"is_complete"`
+	IsActive   bool              `json:"is_active"`
+	IsSplit    bool              `json:"is_split"`
+}
+
+// getHops returns the hops of the path in the format of the trace endpoints. The last hop of an incomplete path may
+// lack the cable and the far end.
+func (p *cableTracePath) getHops() ([]*cableTraceHop, error) {
+	var hops []*cableTraceHop
+	for i := 0; i < len(p.Path); i += 3 {
+		hop := &cableTraceHop{}
+		if err := json.Unmarshal(p.Path[i], &hop.NearEnds); err != nil {
+			return nil, err
+		}
+		if i+1 < len(p.Path) {
+			var cables []*cableTraceCable
+			if err := json.Unmarshal(p.Path[i+1], &cables); err != nil {
+				return nil, err
+			}
+			if len(cables) > 0 {
+				hop.Cable = cables[0]
+			}
+		}
+		if i+2 < len(p.Path) {
+			if err := json.Unmarshal(p.Path[i+2], &hop.FarEnds); err != nil {
+				return nil, err
+			}
+		}
+		hops = append(hops, hop)
+	}
+	return hops, nil
+}
+
+// getObjectTypeFromURL returns the object type of an object given by its API URL, e.g.
+// https://netbox.example.com/api/dcim/interfaces/1/ is a dcim.interface
+func getObjectTypeFromURL(objectURL string) (string, error) {
+	u, err := url.Parse(objectURL)
+	if err != nil {
+		return "", err
+	}
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(segments) < 3 {
+		return "", fmt.Errorf("unexpected object URL %q", objectURL)
+	}
+	endpoint := strings.Join(segments[len(segments)-3:len(segments)-1], "/")
+	objectType, ok := cableTraceEndpointObjectTypes[endpoint]
+	if !ok {
+		return "", fmt.Errorf("unsupported cable termination at %q", objectURL)
+	}
+	return objectType, nil
+}
+
+var cableTraceTerminationSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"object_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"object_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"device_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	},
+}
+
+var cableTraceHopSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"near_end": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     cableTraceTerminationSchema,
+		},
+		"cable_id": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The ID of the cable connecting the near end and the far end. `0` if there is no cable, e.g. at the end of an incomplete path.",
+		},
+		"cable_label": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"far_end": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     cableTraceTerminationSchema,
+		},
+	},
+}
+
+func dataSourceNetboxCableTrace() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetboxCableTraceRead,
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):This data source traces the cable path starting at a path endpoint, e.g. an interface, through all patch panels up to where it ultimately lands.
+
+Front and rear ports are not path endpoints, so Netbox does not trace from them. For them, the data source returns all paths running through the port in ` + "`paths`" + ` instead, each starting at a path endpoint.`,
+		Schema: map[string]*schema.Schema{
+			"object_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(dataSourceNetboxCableTraceObjectTypes, false),
+				Description:  "The object type of the origin of the trace. " + buildValidValueDescription(dataSourceNetboxCableTraceObjectTypes),
+			},
+			"object_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The ID of the origin of the trace.",
+			},
+			"hops": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The segments of the path in order, starting at the origin. Empty for front and rear ports.",
+				Elem:        cableTraceHopSchema,
+			},
+			"is_complete": {
+				Type:     schema.TypeBool,
+				Computed: true,
+				Description: "Whether the path ends at another path endpoint. " +
+					"Netbox does not return the path itself for path endpoints, so this is true if Netbox reports connected endpoints for the origin, which it only does for complete paths.",
+			},
+			"is_reachable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the path is complete and all of its cables are connected.",
+			},
+			"connected_endpoints": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The endpoints at the far end of a complete path.",
+				Elem:        cableTraceTerminationSchema,
+			},
+			"paths": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The paths running through a front or rear port, as reported by Netbox. Empty for path endpoints.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hops": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The segments of the path in order, starting at the path endpoint it originates from.",
+							Elem:        cableTraceHopSchema,
+						},
+						"is_complete": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the path ends at another path endpoint.",
+						},
+						"is_reachable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the path is complete and all of its cables are connected.",
+						},
+						"is_split": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the path splits up into multiple paths, e.g. at a rear port with multiple positions.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxCableTraceRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	objectType := d.Get("object_type").(string)
+	objectID := int64(d.Get("object_id").(int))
+	path := fmt.Sprintf("%s%d/", dataSourceNetboxCableTracePaths[objectType], objectID)
+	d.SetId(fmt.Sprintf("%s:%d", objectType, objectID))
+
+	if slices.Contains(dataSourceNetboxCableTracePassThroughTypes, objectType) {
+		var paths []*cableTracePath
+		err := netboxRequest(api, http.MethodGet, path+"paths/", nil, nil, &paths)
+		if err != nil {
+			return err
+		}
+
+		var s []map[string]interface{}
+		for _, p := range paths {
+			hops, err := p.getHops()
+			if err != nil {
+				return err
+			}
+			hopsForState, err := getCableTraceHopsForState(hops)
+			if err != nil {
+				return err
+			}
+			s = append(s, map[string]interface{}{
+				"hops":         hopsForState,
+				"is_complete":  p.IsComplete,
+				"is_reachable": p.IsActive,
+				"is_split":     p.IsSplit,
+			})
+		}
+
+		d.Set("hops", nil)
+		d.Set("is_complete", false)
+		d.Set("is_reachable", false)
+		d.Set("connected_endpoints", nil)
+		return d.Set("paths", s)
+	}
+
+	// The go-netbox client decodes traces as a single object
+	var hops []*cableTraceHop
+	err := netboxRequest(api, http.MethodGet, path+"trace/", nil, nil, &hops)
+	if err != nil {
+		return err
+	}
+
+	var origin struct {
+		ConnectedEndpoints          []*cableTraceTermination `json:"connected_endpoints"`
+		ConnectedEndpointsReachable bool                     `json:"connected_endpoints_reachable"`
+	}
+	err = netboxRequest(api, http.MethodGet, path, nil, nil, &origin)
+	if err != nil {
+		return err
+	}
+
+	s, err := getCableTraceHopsForState(hops)
+	if err != nil {
+		return err
+	}
+
+	// Netbox only reports connected endpoints for complete paths
+	connectedEndpoints, err := getCableTraceTerminationsForState(origin.ConnectedEndpoints)
+	if err != nil {
+		return err
+	}
+
+	d.Set("is_complete", len(connectedEndpoints) > 0)
+	d.Set("is_reachable", origin.ConnectedEndpointsReachable)
+	d.Set("connected_endpoints", connectedEndpoints)
+	d.Set("paths", nil)
+	return d.Set("hops", s)
+}
+
+func getCableTraceHopsForState(hops []*cableTraceHop) ([]map[string]interface{}, error) {
+	var s []map[string]interface{}
+	for _, hop := range hops {
+		var mapping = make(map[string]interface{})
+
+		nearEnd, err := getCableTraceTerminationsForState(hop.NearEnds)
+		if err != nil {
+			return nil, err
+		}
+		mapping["near_end"] = nearEnd
+		if hop.Cable != nil {
+			mapping["cable_id"] = hop.Cable.ID
+			mapping["cable_label"] = hop.Cable.Label
+		}
+		farEnd, err := getCableTraceTerminationsForState(hop.FarEnds)
+		if err != nil {
+			return nil, err
+		}
+		mapping["far_end"] = farEnd
+
+		s = append(s, mapping)
+	}
+	return s, nil
+}
+
+func getCableTraceTerminationsForState(terminations []*cableTraceTermination) ([]map[string]interface{}, error) {
+	s := make([]map[string]interface{}, 0, len(terminations))
+	for _, termination := range terminations {
+		objectType, err := getObjectTypeFromURL(termination.URL)
+		if err != nil {
+			return nil, err
+		}
+		mapping := map[string]interface{}{
+			"object_type": objectType,
+			"object_id":   termination.ID,
+			"name":        termination.Name,
+		}
+		if termination.Name == "" {
+			mapping["name"] = termination.Display
+		}
+		if termination.Device != nil {
+			mapping["device_id"] = termination.Device.ID
+		}
+		s = append(s, mapping)
+	}
+	return s, nil
+}
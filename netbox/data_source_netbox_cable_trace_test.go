@@ -0,0 +1,328 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCableTraceHopUnmarshal(t *testing.T) {
+	data := `[
+  [[{"id": 1, "url": "https://netbox.example.com/api/dcim/interfaces/1/", "name": "eth0", "device": {"id": 10}}], {"id": 5, "label": "A-1"}, [{"id": 2, "url": "https://netbox.example.com/api/dcim/front-ports/2/", "name": "1", "device": {"id": 11}}]],
+  [[{"id": 3, "url": "https://netbox.example.com/api/dcim/rear-ports/3/", "name": "1", "device": {"id": 11}}], null, []]
+]`
+	var hops []*cableTraceHop
+	if err := json.Unmarshal([]byte(data), &hops); err != nil {
+		t.Fatal(err)
+	}
+	if len(hops) != 2 {
+		t.Fatalf("expected 2 hops, got %d", len(hops))
+	}
+	if hops[0].Cable == nil || hops[0].Cable.ID != 5 || hops[0].Cable.Label != "A-1" {
+		t.Errorf("unexpected cable %+v", hops[0].Cable)
+	}
+	if len(hops[0].FarEnds) != 1 || hops[0].FarEnds[0].Device.ID != 11 {
+		t.Errorf("unexpected far ends %+v", hops[0].FarEnds)
+	}
+	if hops[1].Cable != nil || len(hops[1].FarEnds) != 0 {
+		t.Errorf("expected the last hop to end without cable, got %+v", hops[1])
+	}
+
+	if err := json.Unmarshal([]byte(`[[[], null]]`), &hops); err == nil {
+		t.Error("expected an error for a hop with 2 elements")
+	}
+}
+
+func TestCableTracePathGetHops(t *testing.T) {
+	data := `{
+  "id": 7,
+  "path": [
+    [{"id": 1, "url": "https://netbox.example.com/api/dcim/interfaces/1/", "name": "eth0", "device": {"id": 10}}],
+    [{"id": 5, "url": "https://netbox.example.com/api/dcim/cables/5/", "label": "A-1"}],
+    [{"id": 2, "url": "https://netbox.example.com/api/dcim/front-ports/2/", "name": "1", "device": {"id": 11}}],
+    [{"id": 3, "url": "https://netbox.example.com/api/dcim/rear-ports/3/", "name": "1", "device": {"id": 11}}]
+  ],
+  "is_active": false,
+  "is_complete": false,
+  "is_split": false
+}`
+	var path cableTracePath
+	if err := json.Unmarshal([]byte(data), &path); err != nil {
+		t.Fatal(err)
+	}
+	hops, err := path.getHops()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hops) != 2 {
+		t.Fatalf("expected 2 hops, got %d", len(hops))
+	}
+	if len(hops[0].NearEnds) != 1 || hops[0].NearEnds[0].ID != 1 {
+		t.Errorf("unexpected near ends %+v", hops[0].NearEnds)
+	}
+	if hops[0].Cable == nil || hops[0].Cable.ID != 5 || hops[0].Cable.Label != "A-1" {
+		t.Errorf("unexpected cable %+v", hops[0].Cable)
+	}
+	if len(hops[0].FarEnds) != 1 || hops[0].FarEnds[0].ID != 2 {
+		t.Errorf("unexpected far ends %+v", hops[0].FarEnds)
+	}
+	if len(hops[1].NearEnds) != 1 || hops[1].NearEnds[0].ID != 3 || hops[1].Cable != nil || len(hops[1].FarEnds) != 0 {
+		t.Errorf("expected the last hop to end at the rear port without cable, got %+v", hops[1])
+	}
+}
+
+func TestCableTraceReadRearPort(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/dcim/rear-ports/3/paths/" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{
+  "id": 7,
+  "path": [
+    [{"id": 1, "url": "https://netbox.example.com/api/dcim/interfaces/1/", "name": "eth0", "device": {"id": 10}}],
+    [{"id": 5, "url": "https://netbox.example.com/api/dcim/cables/5/", "label": "A-1"}],
+    [{"id": 3, "url": "https://netbox.example.com/api/dcim/rear-ports/3/", "name": "1", "device": {"id": 11}}],
+    [{"id": 2, "url": "https://netbox.example.com/api/dcim/front-ports/2/", "name": "1", "device": {"id": 11}}],
+    [{"id": 6, "url": "https://netbox.example.com/api/dcim/cables/6/", "label": "A-2"}],
+    [{"id": 4, "url": "https://netbox.example.com/api/dcim/interfaces/4/", "name": "eth1", "device": {"id": 12}}]
+  ],
+  "is_active": true,
+  "is_complete": true,
+  "is_split": false
+}]`)
+	}))
+	defer ts.Close()
+
+	config := Config{APIToken: "07b12b765127747e4afd56cb531b7bf9c61f3c30", ServerURL: ts.URL}
+	api, err := config.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxCableTrace().Schema, map[string]interface{}{
+		"object_type": "dcim.rearport",
+		"object_id":   3,
+	})
+	if err := dataSourceNetboxCableTraceRead(d, api); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "dcim.rearport:3" {
+		t.Errorf("unexpected ID %s", d.Id())
+	}
+	if n := d.Get("paths.#").(int); n != 1 {
+		t.Fatalf("expected 1 path, got %d", n)
+	}
+	if n := d.Get("paths.0.hops.#").(int); n != 2 {
+		t.Errorf("expected 2 hops, got %d", n)
+	}
+	if !d.Get("paths.0.is_complete").(bool) || !d.Get("paths.0.is_reachable").(bool) {
+		t.Error("expected the path to be complete and reachable")
+	}
+	if objectType := d.Get("paths.0.hops.1.far_end.0.object_type").(string); objectType != "dcim.interface" {
+		t.Errorf("expected the path to end at an interface, got %s", objectType)
+	}
+	if cableID := d.Get("paths.0.hops.1.cable_id").(int); cableID != 6 {
+		t.Errorf("expected the second hop to use cable 6, got %d", cableID)
+	}
+}
+
+func TestGetObjectTypeFromURL(t *testing.T) {
+	for _, tt := range []struct {
+		url        string
+		objectType string
+		err        bool
+	}{
+		{url: "https://netbox.example.com/api/dcim/interfaces/1/", objectType: "dcim.interface"},
+		{url: "https://netbox.example.com/netbox/api/dcim/rear-ports/12/", objectType: "dcim.rearport"},
+		{url: "http://localhost:8001/api/circuits/circuit-terminations/3/", objectType: "circuits.circuittermination"},
+		{url: "http://localhost:8001/api/dcim/devices/3/", err: true},
+		{url: "/3/", err: true},
+	} {
+		objectType, err := getObjectTypeFromURL(tt.url)
+		if tt.err {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %s", tt.url, objectType)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tt.url, err)
+		} else if objectType != tt.objectType {
+			t.Errorf("%s: expected %s, got %s", tt.url, tt.objectType, objectType)
+		}
+	}
+}
+
+func testAccNetboxCableTraceDataSourceDependencies(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name = "%[1]s"
+  status = "active"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device_role" "test" {
+  name = "%[1]s"
+  color_hex = "123456"
+}
+
+resource "netbox_device" "a" {
+  name = "%[1]s_a"
+  device_type_id = netbox_device_type.test.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+}
+
+resource "netbox_device" "b" {
+  name = "%[1]s_b"
+  device_type_id = netbox_device_type.test.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+}
+
+resource "netbox_device" "panel" {
+  name = "%[1]s_panel"
+  device_type_id = netbox_device_type.test.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+}
+
+resource "netbox_device_interface" "a" {
+  device_id = netbox_device.a.id
+  name = "eth0"
+  type = "1000base-t"
+}
+
+resource "netbox_device_interface" "b" {
+  device_id = netbox_device.b.id
+  name = "eth0"
+  type = "1000base-t"
+}
+
+resource "netbox_device_interface" "a_uplink" {
+  device_id = netbox_device.a.id
+  name = "eth1"
+  type = "1000base-t"
+}
+
+resource "netbox_device_rear_port" "panel" {
+  device_id = netbox_device.panel.id
+  name = "rear"
+  type = "8p8c"
+  positions = 1
+}
+
+resource "netbox_device_front_port" "panel" {
+  device_id = netbox_device.panel.id
+  name = "front"
+  type = "8p8c"
+  rear_port_id = netbox_device_rear_port.panel.id
+  rear_port_position = 1
+}
+
+resource "netbox_cable" "direct" {
+  a_termination {
+    object_type = "dcim.interface"
+    object_id = netbox_device_interface.a.id
+  }
+  b_termination {
+    object_type = "dcim.interface"
+    object_id = netbox_device_interface.b.id
+  }
+  label = "%[1]s_direct"
+  status = "connected"
+}
+
+resource "netbox_cable" "panel" {
+  a_termination {
+    object_type = "dcim.interface"
+    object_id = netbox_device_interface.a_uplink.id
+  }
+  b_termination {
+    object_type = "dcim.frontport"
+    object_id = netbox_device_front_port.panel.id
+  }
+  status = "planned"
+}
+`, testName)
+}
+
+func TestAccNetboxCableTraceDataSource_basic(t *testing.T) {
+	testSlug := "cable_trace_ds"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxCableTraceDataSourceDependencies(testName) + `
+data "netbox_cable_trace" "direct" {
+  object_type = "dcim.interface"
+  object_id   = netbox_device_interface.a.id
+  depends_on  = [netbox_cable.direct]
+}
+
+data "netbox_cable_trace" "panel" {
+  object_type = "dcim.interface"
+  object_id   = netbox_device_interface.a_uplink.id
+  depends_on  = [netbox_cable.panel]
+}
+
+data "netbox_cable_trace" "front_port" {
+  object_type = "dcim.frontport"
+  object_id   = netbox_device_front_port.panel.id
+  depends_on  = [netbox_cable.panel]
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.direct", "hops.#", "1"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.direct", "hops.0.near_end.0.object_type", "dcim.interface"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.direct", "hops.0.near_end.0.object_id", "netbox_device_interface.a", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.direct", "hops.0.cable_id", "netbox_cable.direct", "id"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.direct", "hops.0.cable_label", testName+"_direct"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.direct", "hops.0.far_end.0.object_id", "netbox_device_interface.b", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.direct", "hops.0.far_end.0.device_id", "netbox_device.b", "id"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.direct", "hops.0.far_end.0.name", "eth0"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.direct", "is_complete", "true"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.direct", "is_reachable", "true"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.direct", "connected_endpoints.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.direct", "connected_endpoints.0.object_id", "netbox_device_interface.b", "id"),
+
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.panel", "hops.0.far_end.0.object_type", "dcim.frontport"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.panel", "hops.0.far_end.0.object_id", "netbox_device_front_port.panel", "id"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.panel", "is_complete", "false"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.panel", "is_reachable", "false"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.panel", "connected_endpoints.#", "0"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.panel", "paths.#", "0"),
+
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.front_port", "hops.#", "0"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.front_port", "paths.#", "1"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.front_port", "paths.0.hops.#", "2"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.front_port", "paths.0.hops.0.near_end.0.object_id", "netbox_device_interface.a_uplink", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.front_port", "paths.0.hops.0.cable_id", "netbox_cable.panel", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.front_port", "paths.0.hops.0.far_end.0.object_id", "netbox_device_front_port.panel", "id"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.front_port", "paths.0.hops.1.near_end.0.object_type", "dcim.rearport"),
+					resource.TestCheckResourceAttrPair("data.netbox_cable_trace.front_port", "paths.0.hops.1.near_end.0.object_id", "netbox_device_rear_port.panel", "id"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.front_port", "paths.0.hops.1.cable_id", "0"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.front_port", "paths.0.is_complete", "false"),
+					resource.TestCheckResourceAttr("data.netbox_cable_trace.front_port", "paths.0.is_split", "false"),
+				),
+			},
+		},
+	})
+}
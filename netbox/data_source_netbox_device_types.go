@@ -0,0 +1,206 @@
+package netbox
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/exp/slices"
+)
+
+// dataSourceNetboxDeviceTypesFilterOptions are the filters passed on to Netbox as they are
+var dataSourceNetboxDeviceTypesFilterOptions = []string{
+	"id", "q", "model", "model__ic", "slug", "part_number", "manufacturer", "manufacturer_id", "u_height",
+	"is_full_depth", "exclude_from_utilization", "subdevice_role", "airflow", "weight", "weight_unit",
+	"default_platform", "default_platform_id", "has_front_image", "has_rear_image", "description", "description__ic",
+	"tag", "tag__n",
+}
+
+func dataSourceNetboxDeviceTypes() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxDeviceTypesRead,
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):`,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: buildValidValueDescription(dataSourceNetboxDeviceTypesFilterOptions),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Default:          0,
+			},
+			"device_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"model": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"manufacturer_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"part_number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"u_height": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"is_full_depth": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"exclude_from_utilization": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"subdevice_role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"airflow": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"weight": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"weight_unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_platform_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"front_image_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rear_image_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"comments": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags":          tagsSchemaRead,
+						customFieldsKey: customFieldsSchema,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxDeviceTypesRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	query := url.Values{}
+
+	if filter, ok := d.GetOk("filter"); ok {
+		var filterParams = filter.(*schema.Set)
+		for _, f := range filterParams.List() {
+			k := f.(map[string]interface{})["name"].(string)
+			v := f.(map[string]interface{})["value"].(string)
+			if !slices.Contains(dataSourceNetboxDeviceTypesFilterOptions, k) {
+				return fmt.Errorf("'%s' is not a supported filter parameter", k)
+			}
+			query.Add(k, v)
+		}
+	}
+
+	// The go-netbox device type model does not include exclude_from_utilization and default_platform
+	deviceTypes, err := netboxList[*deviceType](api, "/dcim/device-types/", query, d.Get("limit").(int))
+	if err != nil {
+		return err
+	}
+
+	if len(deviceTypes) == 0 {
+		return errors.New("no result")
+	}
+
+	var s []map[string]interface{}
+	for _, v := range deviceTypes {
+		var mapping = make(map[string]interface{})
+
+		mapping["id"] = v.ID
+		mapping["model"] = v.Model
+		mapping["slug"] = v.Slug
+		if v.Manufacturer != nil {
+			mapping["manufacturer_id"] = v.Manufacturer.ID
+		}
+		mapping["part_number"] = v.PartNumber
+		mapping["u_height"] = v.UHeight
+		mapping["is_full_depth"] = v.IsFullDepth
+		mapping["exclude_from_utilization"] = v.ExcludeFromUtilization
+		if v.SubdeviceRole != nil {
+			mapping["subdevice_role"] = v.SubdeviceRole.Value
+		}
+		if v.Airflow != nil {
+			mapping["airflow"] = v.Airflow.Value
+		}
+		mapping["weight"] = v.Weight
+		if v.WeightUnit != nil {
+			mapping["weight_unit"] = v.WeightUnit.Value
+		}
+		if v.DefaultPlatform != nil {
+			mapping["default_platform_id"] = v.DefaultPlatform.ID
+		}
+		mapping["front_image_url"] = v.FrontImage.String()
+		mapping["rear_image_url"] = v.RearImage.String()
+		mapping["device_count"] = v.DeviceCount
+		mapping["description"] = v.Description
+		mapping["comments"] = v.Comments
+		mapping["tags"] = getTagListFromNestedTagList(v.Tags)
+		if cf := getCustomFields(api, v.CustomFields); cf != nil {
+			mapping[customFieldsKey] = cf
+		}
+
+		s = append(s, mapping)
+	}
+
+	d.SetId(id.UniqueId())
+	return d.Set("device_types", s)
+}
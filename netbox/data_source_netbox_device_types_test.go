@@ -0,0 +1,68 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxDeviceTypesDataSource_basic(t *testing.T) {
+	testSlug := "device_types_ds"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test_0" {
+  model           = "%[1]s_0"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device_type" "test_1" {
+  model                    = "%[1]s_1"
+  manufacturer_id          = netbox_manufacturer.test.id
+  airflow                  = "rear-to-front"
+  weight                   = 4
+  weight_unit              = "lb"
+  exclude_from_utilization = true
+}
+
+data "netbox_device_types" "test" {
+  filter {
+    name  = "manufacturer_id"
+    value = netbox_manufacturer.test.id
+  }
+  depends_on = [netbox_device_type.test_0, netbox_device_type.test_1]
+}
+
+data "netbox_device_types" "airflow" {
+  filter {
+    name  = "manufacturer_id"
+    value = netbox_manufacturer.test.id
+  }
+  filter {
+    name  = "airflow"
+    value = "rear-to-front"
+  }
+  depends_on = [netbox_device_type.test_0, netbox_device_type.test_1]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_device_types.test", "device_types.#", "2"),
+					resource.TestCheckResourceAttrPair("data.netbox_device_types.test", "device_types.0.manufacturer_id", "netbox_manufacturer.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_device_types.airflow", "device_types.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_device_types.airflow", "device_types.0.id", "netbox_device_type.test_1", "id"),
+					resource.TestCheckResourceAttr("data.netbox_device_types.airflow", "device_types.0.weight", "4"),
+					resource.TestCheckResourceAttr("data.netbox_device_types.airflow", "device_types.0.weight_unit", "lb"),
+					resource.TestCheckResourceAttr("data.netbox_device_types.airflow", "device_types.0.exclude_from_utilization", "true"),
+				),
+			},
+		},
+	})
+}
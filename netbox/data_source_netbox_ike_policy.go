@@ -0,0 +1,72 @@
+package netbox
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxIkePolicy() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxIkePolicyRead,
+		Description: `:meta:subcategory:VPN Tunnels:`,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"proposal_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxIkePolicyRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	query := url.Values{"name": []string{d.Get("name").(string)}}
+
+	var result ikePolicy
+	err := netboxGetSingle(api, "/vpn/ike-policies/", query, "ike policy", &result)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+	d.Set("id", result.ID)
+	d.Set("name", result.Name)
+	if result.Version != nil {
+		d.Set("version", result.Version.Value)
+	}
+	if result.Mode != nil {
+		d.Set("mode", result.Mode.Value)
+	}
+	d.Set("proposal_ids", getIDsFromNetboxNestedObjects(result.Proposals))
+	d.Set("description", result.Description)
+	d.Set("tags", getTagListFromNestedTagList(result.Tags))
+	return nil
+}
@@ -0,0 +1,46 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxIkePolicyDataSource_basic(t *testing.T) {
+	testSlug := "ds_ike_pol"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_ike_proposal" "test" {
+  name                  = "%[1]s"
+  authentication_method = "preshared-keys"
+  encryption_algorithm  = "aes-256-cbc"
+  group                 = 14
+}
+
+resource "netbox_ike_policy" "test" {
+  name         = "%[1]s"
+  version      = 1
+  mode         = "aggressive"
+  proposal_ids = [netbox_ike_proposal.test.id]
+}
+
+data "netbox_ike_policy" "test" {
+  name = netbox_ike_policy.test.name
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_ike_policy.test", "id", "netbox_ike_policy.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_ike_policy.test", "version", "1"),
+					resource.TestCheckResourceAttr("data.netbox_ike_policy.test", "mode", "aggressive"),
+					resource.TestCheckResourceAttr("data.netbox_ike_policy.test", "proposal_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_ike_policy.test", "proposal_ids.0", "netbox_ike_proposal.test", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,83 @@
+package netbox
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxIkeProposal() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxIkeProposalRead,
+		Description: `:meta:subcategory:VPN Tunnels:`,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"authentication_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encryption_algorithm": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authentication_algorithm": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"group": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"sa_lifetime": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxIkeProposalRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	query := url.Values{"name": []string{d.Get("name").(string)}}
+
+	var result ikeProposal
+	err := netboxGetSingle(api, "/vpn/ike-proposals/", query, "ike proposal", &result)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+	d.Set("id", result.ID)
+	d.Set("name", result.Name)
+	if result.AuthenticationMethod != nil {
+		d.Set("authentication_method", result.AuthenticationMethod.Value)
+	}
+	if result.EncryptionAlgorithm != nil {
+		d.Set("encryption_algorithm", result.EncryptionAlgorithm.Value)
+	}
+	if result.AuthenticationAlgorithm != nil {
+		d.Set("authentication_algorithm", result.AuthenticationAlgorithm.Value)
+	}
+	if result.Group != nil {
+		d.Set("group", result.Group.Value)
+	}
+	d.Set("sa_lifetime", result.SaLifetime)
+	d.Set("description", result.Description)
+	d.Set("tags", getTagListFromNestedTagList(result.Tags))
+	return nil
+}
@@ -0,0 +1,44 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxIkeProposalDataSource_basic(t *testing.T) {
+	testSlug := "ds_ike_prop"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_ike_proposal" "test" {
+  name                     = "%[1]s"
+  authentication_method    = "preshared-keys"
+  encryption_algorithm     = "aes-256-cbc"
+  authentication_algorithm = "hmac-sha256"
+  group                    = 14
+  sa_lifetime              = 28800
+  description              = "%[1]s_description"
+}
+
+data "netbox_ike_proposal" "test" {
+  name = netbox_ike_proposal.test.name
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_ike_proposal.test", "id", "netbox_ike_proposal.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_ike_proposal.test", "authentication_method", "preshared-keys"),
+					resource.TestCheckResourceAttr("data.netbox_ike_proposal.test", "encryption_algorithm", "aes-256-cbc"),
+					resource.TestCheckResourceAttr("data.netbox_ike_proposal.test", "authentication_algorithm", "hmac-sha256"),
+					resource.TestCheckResourceAttr("data.netbox_ike_proposal.test", "group", "14"),
+					resource.TestCheckResourceAttr("data.netbox_ike_proposal.test", "sa_lifetime", "28800"),
+					resource.TestCheckResourceAttr("data.netbox_ike_proposal.test", "description", testName+"_description"),
+				),
+			},
+		},
+	})
+}
@@ -1,13 +1,11 @@
 package netbox
 
 import (
-	"errors"
+	"net/url"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client"
-	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceNetboxIPRange() *schema.Resource {
@@ -22,8 +20,50 @@ func dataSourceNetboxIPRange() *schema.Resource {
 			"contains": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validation.IsCIDR,
+				ValidateFunc: validateIPAddressWithMask,
 			},
+			"start_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vrf_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"role_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mark_utilized": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			customFieldsKey: customFieldsSchema,
+			"tags":          tagsSchemaRead,
 		},
 	}
 }
@@ -31,27 +71,39 @@ func dataSourceNetboxIPRange() *schema.Resource {
 func dataSourceNetboxIPRangeRead(d *schema.ResourceData, m interface{}) error {
 	api := m.(*client.NetBoxAPI)
 
-	contains := d.Get("contains").(string)
-
-	params := ipam.NewIpamIPRangesListParams()
-	params.Contains = &contains
-
-	limit := int64(2) // Limit of 2 is enough
-	params.Limit = &limit
+	query := url.Values{"contains": []string{d.Get("contains").(string)}}
 
-	res, err := api.Ipam.IpamIPRangesList(params, nil)
+	var result ipRange
+	err := netboxGetSingle(api, "/ipam/ip-ranges/", query, "ip range", &result)
 	if err != nil {
 		return err
 	}
 
-	if *res.GetPayload().Count > int64(1) {
-		return errors.New("more than one ip range returned, specify a more narrow filter")
-	}
-	if *res.GetPayload().Count == int64(0) {
-		return errors.New("no ip range found matching filter")
-	}
-	result := res.GetPayload().Results[0]
 	d.Set("id", result.ID)
 	d.SetId(strconv.FormatInt(result.ID, 10))
+	d.Set("start_address", result.StartAddress)
+	d.Set("end_address", result.EndAddress)
+	d.Set("size", result.Size)
+	if result.Status != nil {
+		d.Set("status", result.Status.Value)
+	}
+	if result.Vrf != nil {
+		d.Set("vrf_id", result.Vrf.ID)
+	}
+	if result.Tenant != nil {
+		d.Set("tenant_id", result.Tenant.ID)
+	}
+	if result.Role != nil {
+		d.Set("role_id", result.Role.ID)
+	}
+	d.Set("description", result.Description)
+	d.Set("comments", result.Comments)
+	d.Set("mark_utilized", result.MarkUtilized)
+
+	cf := getCustomFields(api, result.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+	d.Set("tags", getTagListFromNestedTagList(result.Tags))
 	return nil
 }
@@ -10,21 +10,36 @@ import (
 func TestAccNetboxIpRangeDataSource_basic(t *testing.T) {
 	testStartIP := "10.0.0.101/24"
 	testEndIP := "10.0.0.150/24"
+	testName := testAccGetTestName("iprange_ds")
 	resource.ParallelTest(t, resource.TestCase{
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[3]s"
+}
 resource "netbox_ip_range" "test" {
   start_address = "%[1]s"
   end_address = "%[2]s"
+  description = "%[3]s"
+  mark_utilized = true
+  tags = [netbox_tag.test.name]
 }
 data "netbox_ip_range" "test" {
   depends_on = [netbox_ip_range.test]
   contains = "%[1]s"
-}`, testStartIP, testEndIP),
+}`, testStartIP, testEndIP, testName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair("data.netbox_ip_range.test", "id", "netbox_ip_range.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_ip_range.test", "start_address", testStartIP),
+					resource.TestCheckResourceAttr("data.netbox_ip_range.test", "end_address", testEndIP),
+					resource.TestCheckResourceAttr("data.netbox_ip_range.test", "size", "50"),
+					resource.TestCheckResourceAttr("data.netbox_ip_range.test", "status", "active"),
+					resource.TestCheckResourceAttr("data.netbox_ip_range.test", "description", testName),
+					resource.TestCheckResourceAttr("data.netbox_ip_range.test", "mark_utilized", "true"),
+					resource.TestCheckResourceAttr("data.netbox_ip_range.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("data.netbox_ip_range.test", "tags.0", testName),
 				),
 				ExpectNonEmptyPlan: false,
 			},
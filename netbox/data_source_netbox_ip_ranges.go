@@ -0,0 +1,159 @@
+package netbox
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/exp/slices"
+)
+
+// dataSourceNetboxIPRangesFilterOptions are the filters passed on to Netbox as they are
+var dataSourceNetboxIPRangesFilterOptions = []string{
+	"id", "family", "start_address", "end_address", "contains", "parent", "status", "status__n", "vrf", "vrf_id",
+	"present_in_vrf_id", "role", "role_id", "tenant", "tenant_id", "mark_utilized", "description", "description__ic",
+	"tag", "tag__n",
+}
+
+func dataSourceNetboxIPRanges() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxIPRangesRead,
+		Description: `:meta:subcategory:IP Address Management (IPAM):`,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: buildValidValueDescription(dataSourceNetboxIPRangesFilterOptions),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Default:          0,
+			},
+			"ip_ranges": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"start_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vrf_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"role_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mark_utilized": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"tags": tagsSchemaRead,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxIPRangesRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	query := url.Values{}
+
+	if filter, ok := d.GetOk("filter"); ok {
+		var filterParams = filter.(*schema.Set)
+		for _, f := range filterParams.List() {
+			k := f.(map[string]interface{})["name"].(string)
+			v := f.(map[string]interface{})["value"].(string)
+			if !slices.Contains(dataSourceNetboxIPRangesFilterOptions, k) {
+				return fmt.Errorf("'%s' is not a supported filter parameter", k)
+			}
+			query.Add(k, v)
+		}
+	}
+
+	// The go-netbox IP range model does not include mark_utilized
+	ipRanges, err := netboxList[*ipRange](api, "/ipam/ip-ranges/", query, d.Get("limit").(int))
+	if err != nil {
+		return err
+	}
+
+	if len(ipRanges) == 0 {
+		return errors.New("no result")
+	}
+
+	var s []map[string]interface{}
+	for _, v := range ipRanges {
+		var mapping = make(map[string]interface{})
+
+		mapping["id"] = v.ID
+		mapping["start_address"] = v.StartAddress
+		mapping["end_address"] = v.EndAddress
+		mapping["size"] = v.Size
+		if v.Status != nil {
+			mapping["status"] = v.Status.Value
+		}
+		if v.Vrf != nil {
+			mapping["vrf_id"] = v.Vrf.ID
+		}
+		if v.Tenant != nil {
+			mapping["tenant_id"] = v.Tenant.ID
+		}
+		if v.Role != nil {
+			mapping["role_id"] = v.Role.ID
+		}
+		mapping["description"] = v.Description
+		mapping["mark_utilized"] = v.MarkUtilized
+		mapping["tags"] = getTagListFromNestedTagList(v.Tags)
+
+		s = append(s, mapping)
+	}
+
+	d.SetId(id.UniqueId())
+	return d.Set("ip_ranges", s)
+}
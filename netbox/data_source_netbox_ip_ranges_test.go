@@ -0,0 +1,68 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxIPRangesDataSource_basic(t *testing.T) {
+	testSlug := "ipranges_ds"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_vrf" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_ip_range" "test_0" {
+  start_address = "10.43.0.10/24"
+  end_address   = "10.43.0.19/24"
+  vrf_id        = netbox_vrf.test.id
+}
+
+resource "netbox_ip_range" "test_1" {
+  start_address = "10.43.0.20/24"
+  end_address   = "10.43.0.29/24"
+  vrf_id        = netbox_vrf.test.id
+  status        = "reserved"
+  mark_utilized = true
+}
+
+data "netbox_ip_ranges" "test" {
+  filter {
+    name  = "vrf_id"
+    value = netbox_vrf.test.id
+  }
+  depends_on = [netbox_ip_range.test_0, netbox_ip_range.test_1]
+}
+
+data "netbox_ip_ranges" "utilized" {
+  filter {
+    name  = "vrf_id"
+    value = netbox_vrf.test.id
+  }
+  filter {
+    name  = "mark_utilized"
+    value = "true"
+  }
+  depends_on = [netbox_ip_range.test_0, netbox_ip_range.test_1]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_ip_ranges.test", "ip_ranges.#", "2"),
+					resource.TestCheckResourceAttr("data.netbox_ip_ranges.test", "ip_ranges.0.size", "10"),
+					resource.TestCheckResourceAttrPair("data.netbox_ip_ranges.test", "ip_ranges.0.vrf_id", "netbox_vrf.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_ip_ranges.utilized", "ip_ranges.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_ip_ranges.utilized", "ip_ranges.0.id", "netbox_ip_range.test_1", "id"),
+					resource.TestCheckResourceAttr("data.netbox_ip_ranges.utilized", "ip_ranges.0.status", "reserved"),
+					resource.TestCheckResourceAttr("data.netbox_ip_ranges.utilized", "ip_ranges.0.mark_utilized", "true"),
+				),
+			},
+		},
+	})
+}
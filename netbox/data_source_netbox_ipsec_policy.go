@@ -0,0 +1,65 @@
+package netbox
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxIpsecPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxIpsecPolicyRead,
+		Description: `:meta:subcategory:VPN Tunnels:`,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"proposal_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"pfs_group": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxIpsecPolicyRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	query := url.Values{"name": []string{d.Get("name").(string)}}
+
+	var result ipsecPolicy
+	err := netboxGetSingle(api, "/vpn/ipsec-policies/", query, "ipsec policy", &result)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+	d.Set("id", result.ID)
+	d.Set("name", result.Name)
+	d.Set("proposal_ids", getIDsFromNetboxNestedObjects(result.Proposals))
+	if result.PfsGroup != nil {
+		d.Set("pfs_group", result.PfsGroup.Value)
+	}
+	d.Set("description", result.Description)
+	d.Set("tags", getTagListFromNestedTagList(result.Tags))
+	return nil
+}
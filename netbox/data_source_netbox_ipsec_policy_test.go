@@ -0,0 +1,42 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxIpsecPolicyDataSource_basic(t *testing.T) {
+	testSlug := "ds_ipsec_pol"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_ipsec_proposal" "test" {
+  name                 = "%[1]s"
+  encryption_algorithm = "aes-256-gcm"
+}
+
+resource "netbox_ipsec_policy" "test" {
+  name         = "%[1]s"
+  proposal_ids = [netbox_ipsec_proposal.test.id]
+  pfs_group    = 20
+}
+
+data "netbox_ipsec_policy" "test" {
+  name = netbox_ipsec_policy.test.name
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_ipsec_policy.test", "id", "netbox_ipsec_policy.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_ipsec_policy.test", "pfs_group", "20"),
+					resource.TestCheckResourceAttr("data.netbox_ipsec_policy.test", "proposal_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_ipsec_policy.test", "proposal_ids.0", "netbox_ipsec_proposal.test", "id"),
+				),
+			},
+		},
+	})
+}
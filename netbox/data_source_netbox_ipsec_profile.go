@@ -0,0 +1,71 @@
+package netbox
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxIpsecProfile() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxIpsecProfileRead,
+		Description: `:meta:subcategory:VPN Tunnels:`,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ike_policy_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"ipsec_policy_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxIpsecProfileRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	query := url.Values{"name": []string{d.Get("name").(string)}}
+
+	var result ipsecProfile
+	err := netboxGetSingle(api, "/vpn/ipsec-profiles/", query, "ipsec profile", &result)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+	d.Set("id", result.ID)
+	d.Set("name", result.Name)
+	if result.Mode != nil {
+		d.Set("mode", result.Mode.Value)
+	}
+	if result.IkePolicy != nil {
+		d.Set("ike_policy_id", result.IkePolicy.ID)
+	}
+	if result.IpsecPolicy != nil {
+		d.Set("ipsec_policy_id", result.IpsecPolicy.ID)
+	}
+	d.Set("description", result.Description)
+	d.Set("tags", getTagListFromNestedTagList(result.Tags))
+	return nil
+}
@@ -0,0 +1,38 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxIpsecProfileDataSource_basic(t *testing.T) {
+	testSlug := "ds_ipsec_prof"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxIpsecProfileFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_ipsec_profile" "test" {
+  name            = "%[1]s"
+  mode            = "esp"
+  ike_policy_id   = netbox_ike_policy.test.id
+  ipsec_policy_id = netbox_ipsec_policy.test.id
+}
+
+data "netbox_ipsec_profile" "test" {
+  name = netbox_ipsec_profile.test.name
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_ipsec_profile.test", "id", "netbox_ipsec_profile.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_ipsec_profile.test", "mode", "esp"),
+					resource.TestCheckResourceAttrPair("data.netbox_ipsec_profile.test", "ike_policy_id", "netbox_ike_policy.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_ipsec_profile.test", "ipsec_policy_id", "netbox_ipsec_policy.test", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,74 @@
+package netbox
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxIpsecProposal() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxIpsecProposalRead,
+		Description: `:meta:subcategory:VPN Tunnels:`,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"encryption_algorithm": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authentication_algorithm": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sa_lifetime_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"sa_lifetime_data": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxIpsecProposalRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	query := url.Values{"name": []string{d.Get("name").(string)}}
+
+	var result ipsecProposal
+	err := netboxGetSingle(api, "/vpn/ipsec-proposals/", query, "ipsec proposal", &result)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+	d.Set("id", result.ID)
+	d.Set("name", result.Name)
+	if result.EncryptionAlgorithm != nil {
+		d.Set("encryption_algorithm", result.EncryptionAlgorithm.Value)
+	}
+	if result.AuthenticationAlgorithm != nil {
+		d.Set("authentication_algorithm", result.AuthenticationAlgorithm.Value)
+	}
+	d.Set("sa_lifetime_seconds", result.SaLifetimeSeconds)
+	d.Set("sa_lifetime_data", result.SaLifetimeData)
+	d.Set("description", result.Description)
+	d.Set("tags", getTagListFromNestedTagList(result.Tags))
+	return nil
+}
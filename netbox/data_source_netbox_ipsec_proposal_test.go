@@ -0,0 +1,40 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxIpsecProposalDataSource_basic(t *testing.T) {
+	testSlug := "ds_ipsec_prop"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_ipsec_proposal" "test" {
+  name                     = "%[1]s"
+  encryption_algorithm     = "aes-256-gcm"
+  authentication_algorithm = "hmac-sha512"
+  sa_lifetime_seconds      = 3600
+  sa_lifetime_data         = 102400
+}
+
+data "netbox_ipsec_proposal" "test" {
+  name = netbox_ipsec_proposal.test.name
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_ipsec_proposal.test", "id", "netbox_ipsec_proposal.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_ipsec_proposal.test", "encryption_algorithm", "aes-256-gcm"),
+					resource.TestCheckResourceAttr("data.netbox_ipsec_proposal.test", "authentication_algorithm", "hmac-sha512"),
+					resource.TestCheckResourceAttr("data.netbox_ipsec_proposal.test", "sa_lifetime_seconds", "3600"),
+					resource.TestCheckResourceAttr("data.netbox_ipsec_proposal.test", "sa_lifetime_data", "102400"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,120 @@
+package netbox
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxL2vpn() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxL2vpnRead,
+		Description: `:meta:subcategory:VPN Tunnels:`,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"name", "slug", "identifier"},
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"name", "slug", "identifier"},
+			},
+			"identifier": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"name", "slug", "identifier"},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"import_target_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"export_target_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaRead,
+		},
+	}
+}
+
+func dataSourceNetboxL2vpnRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	query := url.Values{"limit": []string{"2"}} // Limit of 2 is enough
+	if name, ok := d.Get("name").(string); ok && name != "" {
+		query.Set("name", name)
+	}
+	if slug, ok := d.Get("slug").(string); ok && slug != "" {
+		query.Set("slug", slug)
+	}
+	if identifier, ok := d.Get("identifier").(int); ok && identifier != 0 {
+		query.Set("identifier", strconv.Itoa(identifier))
+	}
+
+	var res struct {
+		Count   int64           `json:"count"`
+		Results []*models.L2VPN `json:"results"`
+	}
+	err := netboxRequest(api, http.MethodGet, "/vpn/l2vpns/", query, nil, &res)
+	if err != nil {
+		return err
+	}
+
+	if res.Count > int64(1) {
+		return errors.New("more than one l2vpn returned, specify a more narrow filter")
+	}
+	if res.Count == int64(0) {
+		return errors.New("no l2vpn found matching filter")
+	}
+	result := res.Results[0]
+	d.SetId(strconv.FormatInt(result.ID, 10))
+	d.Set("id", result.ID)
+	d.Set("name", result.Name)
+	d.Set("slug", result.Slug)
+	d.Set("identifier", result.Identifier)
+	if result.Type != nil {
+		d.Set("type", result.Type.Value)
+	}
+	d.Set("import_target_ids", getIDsFromNestedRouteTargets(result.ImportTargets))
+	d.Set("export_target_ids", getIDsFromNestedRouteTargets(result.ExportTargets))
+	if result.Tenant != nil {
+		d.Set("tenant_id", result.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+	d.Set("description", result.Description)
+	d.Set("tags", getTagListFromNestedTagList(result.Tags))
+	return nil
+}
@@ -0,0 +1,43 @@
+package netbox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxL2vpnDataSource_basic(t *testing.T) {
+	testName := testAccGetTestName("l2vpn_ds_basic")
+	setUp := fmt.Sprintf(`
+resource "netbox_l2vpn" "test" {
+  name       = "%[1]s"
+  type       = "vxlan"
+  identifier = 10300
+}`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: setUp + `
+data "netbox_l2vpn" "test" {
+  name = "nonexistent-l2vpn"
+}`,
+				ExpectError: regexp.MustCompile("no l2vpn found matching filter"),
+			},
+			{
+				Config: setUp + `
+data "netbox_l2vpn" "test" {
+  identifier = netbox_l2vpn.test.identifier
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_l2vpn.test", "id", "netbox_l2vpn.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_l2vpn.test", "name", testName),
+					resource.TestCheckResourceAttr("data.netbox_l2vpn.test", "type", "vxlan"),
+				),
+			},
+		},
+	})
+}
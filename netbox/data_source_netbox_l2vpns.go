@@ -0,0 +1,168 @@
+package netbox
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/exp/slices"
+)
+
+// dataSourceNetboxL2vpnsFilterOptions are the filters passed on to Netbox as they are
+var dataSourceNetboxL2vpnsFilterOptions = []string{
+	"id", "name", "name__ic", "slug", "type", "type__n", "identifier", "identifier__gte", "identifier__lte",
+	"description", "tenant", "tenant_id", "import_target", "import_target_id", "export_target", "export_target_id",
+	"vlan_id", "interface_id", "vminterface_id", "tag", "tag__n",
+}
+
+func dataSourceNetboxL2vpns() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxL2vpnsRead,
+		Description: `:meta:subcategory:VPN Tunnels:`,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: buildValidValueDescription(dataSourceNetboxL2vpnsFilterOptions),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Default:          0,
+			},
+			"l2vpns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identifier": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"import_target_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+						"export_target_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+						"tenant_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tagsSchemaRead,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxL2vpnsRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	query := url.Values{}
+
+	if limitValue, ok := d.GetOk("limit"); ok {
+		query.Set("limit", strconv.Itoa(limitValue.(int)))
+	}
+
+	if filter, ok := d.GetOk("filter"); ok {
+		var filterParams = filter.(*schema.Set)
+		for _, f := range filterParams.List() {
+			k := f.(map[string]interface{})["name"].(string)
+			v := f.(map[string]interface{})["value"].(string)
+			if !slices.Contains(dataSourceNetboxL2vpnsFilterOptions, k) {
+				return fmt.Errorf("'%s' is not a supported filter parameter", k)
+			}
+			query.Add(k, v)
+		}
+	}
+
+	var res struct {
+		Count   int64           `json:"count"`
+		Results []*models.L2VPN `json:"results"`
+	}
+	err := netboxRequest(api, http.MethodGet, "/vpn/l2vpns/", query, nil, &res)
+	if err != nil {
+		return err
+	}
+
+	if res.Count == int64(0) {
+		return errors.New("no result")
+	}
+
+	var s []map[string]interface{}
+	for _, v := range res.Results {
+		var mapping = make(map[string]interface{})
+
+		mapping["id"] = v.ID
+		mapping["name"] = v.Name
+		mapping["slug"] = v.Slug
+		if v.Type != nil {
+			mapping["type"] = v.Type.Value
+		}
+		if v.Identifier != nil {
+			mapping["identifier"] = *v.Identifier
+		}
+		mapping["import_target_ids"] = getIDsFromNestedRouteTargets(v.ImportTargets)
+		mapping["export_target_ids"] = getIDsFromNestedRouteTargets(v.ExportTargets)
+		if v.Tenant != nil {
+			mapping["tenant_id"] = v.Tenant.ID
+		}
+		mapping["description"] = v.Description
+		mapping["tags"] = getTagListFromNestedTagList(v.Tags)
+
+		s = append(s, mapping)
+	}
+
+	d.SetId(id.UniqueId())
+	return d.Set("l2vpns", s)
+}
@@ -0,0 +1,67 @@
+package netbox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxL2vpnsDataSource_basic(t *testing.T) {
+	testName := testAccGetTestName("l2vpns_ds_basic")
+	setUp := fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_l2vpn" "test_0" {
+  name       = "%[1]s_0"
+  type       = "vxlan-evpn"
+  identifier = 10400
+  tags       = [netbox_tag.test.name]
+}
+
+resource "netbox_l2vpn" "test_1" {
+  name       = "%[1]s_1"
+  type       = "vxlan-evpn"
+  identifier = 10401
+  tags       = [netbox_tag.test.name]
+}`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: setUp + `
+data "netbox_l2vpns" "test" {
+  filter {
+    name  = "tag"
+    value = netbox_tag.test.slug
+  }
+  filter {
+    name  = "identifier__gte"
+    value = "10401"
+  }
+  depends_on = [netbox_l2vpn.test_0, netbox_l2vpn.test_1]
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_l2vpns.test", "l2vpns.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_l2vpns.test", "l2vpns.0.id", "netbox_l2vpn.test_1", "id"),
+					resource.TestCheckResourceAttr("data.netbox_l2vpns.test", "l2vpns.0.identifier", "10401"),
+					resource.TestCheckResourceAttr("data.netbox_l2vpns.test", "l2vpns.0.tags.#", "1"),
+				),
+			},
+			{
+				Config: setUp + `
+data "netbox_l2vpns" "test" {
+  filter {
+    name  = "not_a_filter"
+    value = "foo"
+  }
+}`,
+				ExpectError: regexp.MustCompile("'not_a_filter' is not a supported filter parameter"),
+			},
+		},
+	})
+}
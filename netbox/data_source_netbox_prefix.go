@@ -24,7 +24,7 @@ func dataSourceNetboxPrefix() *schema.Resource {
 				Optional:      true,
 				Deprecated:    "The `cidr` parameter is deprecated in favor of the canonical `prefix` attribute.",
 				ConflictsWith: []string{"prefix"},
-				ValidateFunc:  validation.IsCIDR,
+				ValidateFunc:  validatePrefix,
 				AtLeastOneOf:  []string{"description", "family", "prefix", "vlan_vid", "vrf_id", "vlan_id", "site_id", "role_id", "cidr", "tag"},
 			},
 			customFieldsKey: customFieldsSchema,
@@ -52,7 +52,7 @@ func dataSourceNetboxPrefix() *schema.Resource {
 			"prefix": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ValidateFunc:  validation.IsCIDR,
+				ValidateFunc:  validatePrefix,
 				ConflictsWith: []string{"cidr"},
 				AtLeastOneOf:  []string{"description", "family", "prefix", "vlan_vid", "vrf_id", "vlan_id", "site_id", "role_id", "cidr", "tag"},
 			},
@@ -176,7 +176,7 @@ func dataSourceNetboxPrefixRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("family", int(*result.Family.Value))
 	d.Set("tags", getTagListFromNestedTagList(result.Tags))
 
-	cf := getCustomFields(result.CustomFields)
+	cf := getCustomFields(api, result.CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
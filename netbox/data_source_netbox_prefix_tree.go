@@ -0,0 +1,429 @@
+package netbox
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// prefixTreeNode is a prefix along with the objects nested in it
+type prefixTreeNode struct {
+	prefix   *models.Prefix
+	network  netip.Prefix
+	parent   *prefixTreeNode
+	children []*prefixTreeNode
+	// ipRanges are the IP ranges for which this is the innermost prefix
+	ipRanges []*ipRange
+	// ipAddressCount is the number of distinct IP addresses within this prefix, including those in child prefixes
+	ipAddressCount int64
+	// utilized is the number of addresses within this prefix that are in use, i.e. IP addresses and the addresses of
+	// IP ranges marked as utilized
+	utilized *big.Int
+}
+
+func dataSourceNetboxPrefixTree() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetboxPrefixTreeRead,
+		Description: `:meta:subcategory:IP Address Management (IPAM):Returns the hierarchy of prefixes below a root prefix or within a VRF, along with the IP ranges and the number of IP addresses in each prefix.
+
+Utilization follows the rules of Netbox: container prefixes are utilized by their child prefixes, all other prefixes by their IP addresses and the IP ranges marked as utilized. Prefixes marked as utilized are always fully utilized.`,
+		Schema: map[string]*schema.Schema{
+			"prefix_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"prefix_id", "vrf_id"},
+				Description:  "The ID of the root prefix. The tree contains the root prefix and all prefixes within it in the same VRF.",
+			},
+			"vrf_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"prefix_id", "vrf_id"},
+				Description:  "The ID of a VRF. The tree contains all prefixes of the VRF.",
+			},
+			"prefixes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The prefixes of the tree, each followed by its child prefixes.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the innermost prefix containing this prefix, `0` for the top level prefixes of the tree.",
+						},
+						"depth": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The depth of the prefix in its VRF as computed by Netbox, `0` for top level prefixes.",
+						},
+						"children": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of prefixes within this prefix as computed by Netbox.",
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vrf_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"is_pool": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"mark_utilized": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of IP addresses within this prefix, including those in child prefixes.",
+						},
+						"ip_range_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "The IDs of the IP ranges for which this is the innermost prefix.",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of usable addresses. Values that do not fit into a number are capped at the largest number.",
+						},
+						"used": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"free": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"utilization": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The utilization in percent.",
+						},
+					},
+				},
+			},
+			"ip_ranges": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"start_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mark_utilized": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"prefix_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the innermost prefix containing the IP range, `0` if there is none.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxPrefixTreeRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	query := url.Values{}
+	prefixQuery := url.Values{}
+	if prefixID, ok := d.GetOk("prefix_id"); ok {
+		params := ipam.NewIpamPrefixesReadParams().WithID(int64(prefixID.(int)))
+		res, err := api.Ipam.IpamPrefixesRead(params, nil)
+		if err != nil {
+			return err
+		}
+		root := res.GetPayload()
+
+		query.Set("parent", *root.Prefix)
+		prefixQuery.Set("within_include", *root.Prefix)
+		if root.Vrf != nil {
+			query.Set("vrf_id", strconv.FormatInt(root.Vrf.ID, 10))
+		} else {
+			query.Set("vrf_id", "null")
+		}
+		d.SetId(strconv.FormatInt(root.ID, 10))
+	} else {
+		vrfID := strconv.Itoa(d.Get("vrf_id").(int))
+		query.Set("vrf_id", vrfID)
+		d.SetId("vrf-" + vrfID)
+	}
+	prefixQuery.Set("vrf_id", query.Get("vrf_id"))
+
+	prefixes, err := netboxListAll[*models.Prefix](api, "/ipam/prefixes/", prefixQuery)
+	if err != nil {
+		return err
+	}
+	ipRanges, err := netboxListAll[*ipRange](api, "/ipam/ip-ranges/", query)
+	if err != nil {
+		return err
+	}
+	query.Set("brief", "true")
+	ipAddresses, err := netboxListAll[*models.IPAddress](api, "/ipam/ip-addresses/", query)
+	if err != nil {
+		return err
+	}
+
+	var addresses []netip.Addr
+	for _, ipAddress := range ipAddresses {
+		if ipAddress.Address == nil {
+			continue
+		}
+		address, err := netip.ParsePrefix(*ipAddress.Address)
+		if err != nil {
+			return fmt.Errorf("failed to parse ip address %s: %w", *ipAddress.Address, err)
+		}
+		addresses = append(addresses, address.Addr())
+	}
+
+	nodes, err := buildPrefixTree(prefixes, ipRanges, addresses)
+	if err != nil {
+		return err
+	}
+
+	var prefixList []map[string]interface{}
+	rangePrefixIDs := make(map[int64]int64)
+	for _, node := range nodes {
+		var mapping = make(map[string]interface{})
+
+		mapping["id"] = node.prefix.ID
+		mapping["prefix"] = node.prefix.Prefix
+		if node.parent != nil {
+			mapping["parent_id"] = node.parent.prefix.ID
+		}
+		mapping["depth"] = node.prefix.Depth
+		mapping["children"] = node.prefix.Children
+		if node.prefix.Status != nil {
+			mapping["status"] = node.prefix.Status.Value
+		}
+		if node.prefix.Vrf != nil {
+			mapping["vrf_id"] = node.prefix.Vrf.ID
+		}
+		mapping["is_pool"] = node.prefix.IsPool
+		mapping["mark_utilized"] = node.prefix.MarkUtilized
+		mapping["description"] = node.prefix.Description
+		mapping["ip_address_count"] = node.ipAddressCount
+
+		ipRangeIDs := make([]int64, 0, len(node.ipRanges))
+		for _, r := range node.ipRanges {
+			ipRangeIDs = append(ipRangeIDs, r.ID)
+			rangePrefixIDs[r.ID] = node.prefix.ID
+		}
+		mapping["ip_range_ids"] = ipRangeIDs
+
+		mapping["size"], mapping["used"], mapping["free"], mapping["utilization"] = capacityValues(node.capacity())
+
+		prefixList = append(prefixList, mapping)
+	}
+
+	var ipRangeList []map[string]interface{}
+	for _, r := range ipRanges {
+		var mapping = make(map[string]interface{})
+
+		mapping["id"] = r.ID
+		mapping["start_address"] = r.StartAddress
+		mapping["end_address"] = r.EndAddress
+		mapping["size"] = r.Size
+		if r.Status != nil {
+			mapping["status"] = r.Status.Value
+		}
+		mapping["mark_utilized"] = r.MarkUtilized
+		mapping["description"] = r.Description
+		mapping["prefix_id"] = rangePrefixIDs[r.ID]
+
+		ipRangeList = append(ipRangeList, mapping)
+	}
+
+	d.Set("prefixes", prefixList)
+	return d.Set("ip_ranges", ipRangeList)
+}
+
+// buildPrefixTree nests the given prefixes and assigns the IP ranges and addresses to them. The returned nodes are
+// sorted so that every prefix is followed by its child prefixes.
+func buildPrefixTree(prefixes []*models.Prefix, ipRanges []*ipRange, addresses []netip.Addr) ([]*prefixTreeNode, error) {
+	nodes := make([]*prefixTreeNode, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		if prefix.Prefix == nil {
+			continue
+		}
+		network, err := netip.ParsePrefix(*prefix.Prefix)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse prefix %s: %w", *prefix.Prefix, err)
+		}
+		nodes = append(nodes, &prefixTreeNode{prefix: prefix, network: network.Masked(), utilized: new(big.Int)})
+	}
+
+	// Sorted by address and then by length, every prefix comes after all prefixes containing it
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if c := nodes[i].network.Addr().Compare(nodes[j].network.Addr()); c != 0 {
+			return c < 0
+		}
+		return nodes[i].network.Bits() < nodes[j].network.Bits()
+	})
+
+	var stack []*prefixTreeNode
+	for _, node := range nodes {
+		for len(stack) > 0 && !prefixContainsPrefix(stack[len(stack)-1].network, node.network) {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) > 0 {
+			node.parent = stack[len(stack)-1]
+			node.parent.children = append(node.parent.children, node)
+		}
+		stack = append(stack, node)
+	}
+
+	var utilizedRanges [][2]netip.Addr
+	for _, r := range ipRanges {
+		if r.StartAddress == nil || r.EndAddress == nil {
+			continue
+		}
+		start, err := netip.ParsePrefix(*r.StartAddress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse ip range start address %s: %w", *r.StartAddress, err)
+		}
+		end, err := netip.ParsePrefix(*r.EndAddress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse ip range end address %s: %w", *r.EndAddress, err)
+		}
+
+		node := findInnermostPrefix(nodes, func(network netip.Prefix) bool {
+			return network.Contains(start.Addr()) && network.Contains(end.Addr())
+		})
+		if node == nil {
+			continue
+		}
+		node.ipRanges = append(node.ipRanges, r)
+		if r.MarkUtilized {
+			utilizedRanges = append(utilizedRanges, [2]netip.Addr{start.Addr(), end.Addr()})
+			size := ipRangeSize(start.Addr(), end.Addr())
+			for _, n := range nodes {
+				if n.network.Contains(start.Addr()) && n.network.Contains(end.Addr()) {
+					n.utilized.Add(n.utilized, size)
+				}
+			}
+		}
+	}
+
+	seen := make(map[netip.Addr]bool)
+	for _, address := range addresses {
+		if seen[address] {
+			continue
+		}
+		seen[address] = true
+
+		// Addresses within a utilized range are already counted as utilized
+		inUtilizedRange := false
+		for _, r := range utilizedRanges {
+			if r[0].Compare(address) <= 0 && address.Compare(r[1]) <= 0 {
+				inUtilizedRange = true
+				break
+			}
+		}
+		for _, n := range nodes {
+			if !n.network.Contains(address) {
+				continue
+			}
+			n.ipAddressCount++
+			if !inUtilizedRange {
+				n.utilized.Add(n.utilized, big.NewInt(1))
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+// prefixContainsPrefix returns true if outer contains inner and is larger than inner
+func prefixContainsPrefix(outer, inner netip.Prefix) bool {
+	return outer.Bits() < inner.Bits() && outer.Contains(inner.Addr())
+}
+
+// findInnermostPrefix returns the most specific of the given nodes for which contains returns true
+func findInnermostPrefix(nodes []*prefixTreeNode, contains func(netip.Prefix) bool) *prefixTreeNode {
+	var innermost *prefixTreeNode
+	for _, node := range nodes {
+		if contains(node.network) && (innermost == nil || node.network.Bits() > innermost.network.Bits()) {
+			innermost = node
+		}
+	}
+	return innermost
+}
+
+// capacity returns the number of usable and used addresses of the prefix the way Netbox computes its utilization
+func (node *prefixTreeNode) capacity() (*big.Int, *big.Int) {
+	size := prefixSize(node.network)
+	if node.prefix.MarkUtilized {
+		return size, size
+	}
+
+	if node.prefix.Status != nil && node.prefix.Status.Value != nil && *node.prefix.Status.Value == "container" {
+		used := new(big.Int)
+		seen := make(map[netip.Prefix]bool)
+		for _, child := range node.children {
+			if !seen[child.network] {
+				seen[child.network] = true
+				used.Add(used, prefixSize(child.network))
+			}
+		}
+		return size, used
+	}
+
+	// The network and broadcast addresses of IPv4 prefixes are not usable, unless the prefix is a pool
+	if node.network.Addr().Is4() && node.network.Bits() < 31 && !node.prefix.IsPool {
+		size.Sub(size, big.NewInt(2))
+	}
+	return size, new(big.Int).Set(node.utilized)
+}
@@ -0,0 +1,166 @@
+package netbox
+
+import (
+	"fmt"
+	"math"
+	"net/netip"
+	"reflect"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestBuildPrefixTree(t *testing.T) {
+	newPrefix := func(id int64, prefix, status string) *models.Prefix {
+		return &models.Prefix{ID: id, Prefix: strToPtr(prefix), Status: &models.PrefixStatus{Value: strToPtr(status)}}
+	}
+	newIPRange := func(id int64, start, end string, markUtilized bool) *ipRange {
+		r := &ipRange{MarkUtilized: markUtilized}
+		r.ID = id
+		r.StartAddress = strToPtr(start)
+		r.EndAddress = strToPtr(end)
+		return r
+	}
+
+	utilizedPrefix := newPrefix(6, "10.1.0.0/24", "active")
+	utilizedPrefix.MarkUtilized = true
+	poolPrefix := newPrefix(7, "10.2.0.0/30", "active")
+	poolPrefix.IsPool = true
+
+	prefixes := []*models.Prefix{
+		newPrefix(3, "10.0.1.0/24", "active"),
+		newPrefix(1, "10.0.0.0/16", "container"),
+		newPrefix(2, "10.0.0.0/24", "active"),
+		newPrefix(4, "10.0.1.0/24", "active"),
+		newPrefix(5, "10.0.1.128/25", "active"),
+		utilizedPrefix,
+		poolPrefix,
+		newPrefix(8, "2001:db8::/64", "active"),
+	}
+	ipRanges := []*ipRange{
+		newIPRange(1, "10.0.0.10/24", "10.0.0.19/24", true),
+		newIPRange(2, "10.0.0.100/24", "10.0.0.109/24", false),
+		newIPRange(3, "10.0.1.200/24", "10.0.1.201/24", false),
+		newIPRange(4, "192.168.0.1/24", "192.168.0.2/24", false),
+	}
+	var addresses []netip.Addr
+	for _, address := range []string{"10.0.0.1", "10.0.0.1", "10.0.0.15", "10.0.0.100", "10.0.1.1", "10.0.1.130", "10.2.0.0", "2001:db8::1"} {
+		addresses = append(addresses, netip.MustParseAddr(address))
+	}
+
+	nodes, err := buildPrefixTree(prefixes, ipRanges, addresses)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type nodeSummary struct {
+		ID             int64
+		ParentID       int64
+		IPRangeIDs     []int64
+		IPAddressCount int64
+		Size           int
+		Used           int
+	}
+	expected := []nodeSummary{
+		{ID: 1, ParentID: 0, IPRangeIDs: nil, IPAddressCount: 5, Size: 65536, Used: 512},
+		{ID: 2, ParentID: 1, IPRangeIDs: []int64{1, 2}, IPAddressCount: 3, Size: 254, Used: 12},
+		{ID: 3, ParentID: 1, IPRangeIDs: nil, IPAddressCount: 2, Size: 254, Used: 2},
+		{ID: 4, ParentID: 1, IPRangeIDs: nil, IPAddressCount: 2, Size: 254, Used: 2},
+		{ID: 5, ParentID: 4, IPRangeIDs: []int64{3}, IPAddressCount: 1, Size: 126, Used: 1},
+		{ID: 6, ParentID: 0, IPRangeIDs: nil, IPAddressCount: 0, Size: 256, Used: 256},
+		{ID: 7, ParentID: 0, IPRangeIDs: nil, IPAddressCount: 1, Size: 4, Used: 1},
+		{ID: 8, ParentID: 0, IPRangeIDs: nil, IPAddressCount: 1, Size: math.MaxInt, Used: 1},
+	}
+
+	var actual []nodeSummary
+	for _, node := range nodes {
+		summary := nodeSummary{ID: node.prefix.ID, IPAddressCount: node.ipAddressCount}
+		if node.parent != nil {
+			summary.ParentID = node.parent.prefix.ID
+		}
+		for _, r := range node.ipRanges {
+			summary.IPRangeIDs = append(summary.IPRangeIDs, r.ID)
+		}
+		summary.Size, summary.Used, _, _ = capacityValues(node.capacity())
+		actual = append(actual, summary)
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("\n\nexpected:\n\n%+v\n\ngot:\n\n%+v\n\n", expected, actual)
+	}
+}
+
+func TestAccNetboxPrefixTreeDataSource_basic(t *testing.T) {
+	testSlug := "prefix_tree"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_vrf" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_prefix" "root" {
+  prefix = "10.44.0.0/22"
+  status = "container"
+  vrf_id = netbox_vrf.test.id
+}
+
+resource "netbox_prefix" "child" {
+  prefix = "10.44.0.0/24"
+  status = "active"
+  vrf_id = netbox_vrf.test.id
+}
+
+resource "netbox_ip_range" "test" {
+  start_address = "10.44.0.10/24"
+  end_address   = "10.44.0.19/24"
+  vrf_id        = netbox_vrf.test.id
+  mark_utilized = true
+}
+
+resource "netbox_ip_address" "test" {
+  ip_address = "10.44.0.1/24"
+  status     = "active"
+  vrf_id     = netbox_vrf.test.id
+}
+
+data "netbox_prefix_tree" "by_prefix" {
+  prefix_id  = netbox_prefix.root.id
+  depends_on = [netbox_prefix.child, netbox_ip_range.test, netbox_ip_address.test]
+}
+
+data "netbox_prefix_tree" "by_vrf" {
+  vrf_id     = netbox_vrf.test.id
+  depends_on = [netbox_prefix.root, netbox_prefix.child, netbox_ip_range.test, netbox_ip_address.test]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_prefix_tree.by_prefix", "prefixes.#", "2"),
+					resource.TestCheckResourceAttrPair("data.netbox_prefix_tree.by_prefix", "prefixes.0.id", "netbox_prefix.root", "id"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_tree.by_prefix", "prefixes.0.parent_id", "0"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_tree.by_prefix", "prefixes.0.depth", "0"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_tree.by_prefix", "prefixes.0.children", "1"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_tree.by_prefix", "prefixes.0.ip_address_count", "1"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_tree.by_prefix", "prefixes.0.size", "1024"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_tree.by_prefix", "prefixes.0.used", "256"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_tree.by_prefix", "prefixes.0.utilization", "25"),
+					resource.TestCheckResourceAttrPair("data.netbox_prefix_tree.by_prefix", "prefixes.1.id", "netbox_prefix.child", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_prefix_tree.by_prefix", "prefixes.1.parent_id", "netbox_prefix.root", "id"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_tree.by_prefix", "prefixes.1.depth", "1"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_tree.by_prefix", "prefixes.1.ip_range_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_tree.by_prefix", "prefixes.1.size", "254"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_tree.by_prefix", "prefixes.1.used", "11"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_tree.by_prefix", "ip_ranges.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_prefix_tree.by_prefix", "ip_ranges.0.id", "netbox_ip_range.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netbox_prefix_tree.by_prefix", "ip_ranges.0.prefix_id", "netbox_prefix.child", "id"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_tree.by_vrf", "prefixes.#", "2"),
+					resource.TestCheckResourceAttr("data.netbox_prefix_tree.by_vrf", "ip_ranges.#", "1"),
+				),
+			},
+		},
+	})
+}
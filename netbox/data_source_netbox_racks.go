@@ -273,7 +273,7 @@ func dataSourceNetboxRacksRead(d *schema.ResourceData, m interface{}) error {
 		mapping["mounting_depth"] = v.MountingDepth
 		mapping["description"] = v.Description
 		mapping["comments"] = v.Comments
-		mapping["custom_fields"] = getCustomFields(v.CustomFields)
+		mapping["custom_fields"] = getCustomFields(api, v.CustomFields)
 
 		s = append(s, mapping)
 	}
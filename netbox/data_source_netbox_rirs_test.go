@@ -0,0 +1,108 @@
+package netbox
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccNetboxRirsDataSource_basic(t *testing.T) {
+	testSlug := "rirs_ds"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_rir" "public" {
+  name = "%[1]s_public"
+}
+
+resource "netbox_rir" "private" {
+  name       = "%[1]s_private"
+  is_private = true
+}
+
+data "netbox_rirs" "test" {
+  filter {
+    name  = "name__ic"
+    value = "%[1]s"
+  }
+  depends_on = [netbox_rir.public, netbox_rir.private]
+}
+
+data "netbox_rirs" "private" {
+  filter {
+    name  = "name__ic"
+    value = "%[1]s"
+  }
+  filter {
+    name  = "is_private"
+    value = "true"
+  }
+  depends_on = [netbox_rir.public, netbox_rir.private]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_rirs.test", "rirs.#", "2"),
+					resource.TestCheckResourceAttr("data.netbox_rirs.private", "rirs.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_rirs.private", "rirs.0.id", "netbox_rir.private", "id"),
+					resource.TestCheckResourceAttr("data.netbox_rirs.private", "rirs.0.is_private", "true"),
+					resource.TestCheckResourceAttr("data.netbox_rirs.private", "rirs.0.aggregate_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestRirsDataSourceReadPages(t *testing.T) {
+	// The server returns at most two RIRs per page, regardless of the requested limit
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/ipam/rirs/", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("is_private"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		var results string
+		for id := offset + 1; id <= 3 && id <= offset+2 && id <= offset+limit; id++ {
+			if results != "" {
+				results += ","
+			}
+			results += fmt.Sprintf(`{"id": %d, "name": "RIR %d", "slug": "rir-%d"}`, id, id, id)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"count": 3, "results": [%s]}`, results)
+	}))
+	defer ts.Close()
+
+	config := Config{APIToken: "07b12b765127747e4afd56cb531b7bf9c61f3c30", ServerURL: ts.URL}
+	api, err := config.Client()
+	assert.NoError(t, err)
+
+	for _, tt := range []struct {
+		name     string
+		limit    int
+		expected int
+	}{
+		{name: "All", expected: 3},
+		{name: "Limit", limit: 1, expected: 1},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"filter": []interface{}{map[string]interface{}{"name": "is_private", "value": "true"}},
+			}
+			if tt.limit != 0 {
+				raw["limit"] = tt.limit
+			}
+			d := schema.TestResourceDataRaw(t, dataSourceNetboxRirs().Schema, raw)
+
+			assert.NoError(t, dataSourceNetboxRirsRead(d, api))
+			assert.Len(t, d.Get("rirs").([]interface{}), tt.expected)
+		})
+	}
+}
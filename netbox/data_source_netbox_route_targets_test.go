@@ -0,0 +1,74 @@
+package netbox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxRouteTargetsDataSource_basic(t *testing.T) {
+	testSlug := "rts"
+	testName := testAccGetTestName(testSlug)
+	setUp := fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_tenant" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_route_target" "test_0" {
+  name      = "%[1]s0"
+  tenant_id = netbox_tenant.test.id
+  tags      = [netbox_tag.test.name]
+}
+
+resource "netbox_route_target" "test_1" {
+  name      = "%[1]s1"
+  tenant_id = netbox_tenant.test.id
+}`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: setUp + `
+data "netbox_route_targets" "by_tenant" {
+  filter {
+    name  = "tenant_id"
+    value = netbox_tenant.test.id
+  }
+  depends_on = [netbox_route_target.test_0, netbox_route_target.test_1]
+}
+
+data "netbox_route_targets" "by_tag" {
+  filter {
+    name  = "tag"
+    value = netbox_tag.test.slug
+  }
+  depends_on = [netbox_route_target.test_0]
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_route_targets.by_tenant", "route_targets.#", "2"),
+					resource.TestCheckResourceAttrPair("data.netbox_route_targets.by_tenant", "route_targets.0.tenant_id", "netbox_tenant.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_route_targets.by_tag", "route_targets.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbox_route_targets.by_tag", "route_targets.0.id", "netbox_route_target.test_0", "id"),
+					resource.TestCheckResourceAttr("data.netbox_route_targets.by_tag", "route_targets.0.tags.#", "1"),
+				),
+			},
+			{
+				Config: setUp + `
+data "netbox_route_targets" "invalid" {
+  filter {
+    name  = "not_a_filter"
+    value = "foo"
+  }
+}`,
+				ExpectError: regexp.MustCompile("'not_a_filter' is not a supported filter parameter"),
+			},
+		},
+	})
+}
@@ -0,0 +1,90 @@
+package netbox
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetboxServiceTemplate() *schema.Resource {
+	return &schema.Resource{
+		Read:        dataSourceNetboxServiceTemplateRead,
+		Description: `:meta:subcategory:IP Address Management (IPAM):`,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ports": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			tagsKey:         tagsSchemaRead,
+			customFieldsKey: customFieldsSchema,
+		},
+	}
+}
+
+func dataSourceNetboxServiceTemplateRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	name := d.Get("name").(string)
+
+	params := ipam.NewIpamServiceTemplatesListParams()
+	params.Name = &name
+
+	limit := int64(2)
+	params.Limit = &limit
+
+	res, err := api.Ipam.IpamServiceTemplatesList(params, nil)
+	if err != nil {
+		return err
+	}
+
+	if *res.GetPayload().Count > int64(1) {
+		return errors.New("more than one service template returned, specify a more narrow filter")
+	}
+	if *res.GetPayload().Count == int64(0) {
+		return errors.New("no service template found matching filter")
+	}
+	result := res.GetPayload().Results[0]
+	d.SetId(strconv.FormatInt(result.ID, 10))
+	d.Set("id", result.ID)
+	d.Set("name", result.Name)
+	if result.Protocol != nil {
+		d.Set("protocol", result.Protocol.Value)
+	}
+	d.Set("ports", result.Ports)
+	d.Set("description", result.Description)
+	d.Set("comments", result.Comments)
+	d.Set(tagsKey, getTagListFromNestedTagList(result.Tags))
+
+	cf := getCustomFields(api, result.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxServiceTemplateDataSource_basic(t *testing.T) {
+	testSlug := "svc_tmpl_ds"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_service_template" "test" {
+  name = "%[1]s"
+  protocol = "tcp"
+  ports = [22]
+  description = "%[1]s"
+}
+
+data "netbox_service_template" "test" {
+  name = "%[1]s"
+  depends_on = [netbox_service_template.test]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_service_template.test", "id", "netbox_service_template.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_service_template.test", "protocol", "tcp"),
+					resource.TestCheckResourceAttr("data.netbox_service_template.test", "ports.#", "1"),
+					resource.TestCheckResourceAttr("data.netbox_service_template.test", "ports.0", "22"),
+					resource.TestCheckResourceAttr("data.netbox_service_template.test", "description", testName),
+				),
+			},
+		},
+	})
+}
@@ -30,3 +30,35 @@ data "netbox_vrf" "test" {
 		},
 	})
 }
+
+func TestAccNetboxVrfDataSource_routeTargets(t *testing.T) {
+	testSlug := "vdrt"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_route_target" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_vrf" "test" {
+  name              = "%[1]s"
+  import_target_ids = [netbox_route_target.test.id]
+  export_target_ids = [netbox_route_target.test.id]
+}
+
+data "netbox_vrf" "test" {
+  depends_on = [netbox_vrf.test]
+  name       = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbox_vrf.test", "import_target_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("data.netbox_vrf.test", "import_target_ids.*", "netbox_route_target.test", "id"),
+					resource.TestCheckTypeSetElemAttrPair("data.netbox_vrf.test", "export_target_ids.*", "netbox_route_target.test", "id"),
+				),
+			},
+		},
+	})
+}
@@ -63,6 +63,20 @@ func dataSourceNetboxVrfs() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
+						"import_target_ids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+						"export_target_ids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
 					},
 				},
 			},
@@ -144,6 +158,8 @@ func dataSourceNetboxVrfsRead(d *schema.ResourceData, m interface{}) error {
 		if v.Tenant != nil {
 			mapping["tenant"] = v.Tenant.ID
 		}
+		mapping["import_target_ids"] = getIDsFromNestedRouteTargets(v.ImportTargets)
+		mapping["export_target_ids"] = getIDsFromNestedRouteTargets(v.ExportTargets)
 
 		s = append(s, mapping)
 	}
@@ -0,0 +1,305 @@
+package netbox
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// deviceTypeDefinition is a device type in the format of the community devicetype-library
+// (https://github.com/netbox-community/devicetype-library). Keys that are not listed here, e.g. inventory items or
+// images, are ignored.
+type deviceTypeDefinition struct {
+	Manufacturer  string   `yaml:"manufacturer"`
+	Model         string   `yaml:"model"`
+	Slug          string   `yaml:"slug"`
+	PartNumber    string   `yaml:"part_number,omitempty"`
+	UHeight       *float64 `yaml:"u_height"`
+	IsFullDepth   *bool    `yaml:"is_full_depth"`
+	Airflow       string   `yaml:"airflow,omitempty"`
+	SubdeviceRole string   `yaml:"subdevice_role,omitempty"`
+	Weight        *float64 `yaml:"weight,omitempty"`
+	WeightUnit    string   `yaml:"weight_unit,omitempty"`
+	Description   string   `yaml:"description,omitempty"`
+	Comments      string   `yaml:"comments,omitempty"`
+
+	Interfaces         []*deviceTypeComponentDefinition `yaml:"interfaces,omitempty"`
+	ConsolePorts       []*deviceTypeComponentDefinition `yaml:"console-ports,omitempty"`
+	ConsoleServerPorts []*deviceTypeComponentDefinition `yaml:"console-server-ports,omitempty"`
+	PowerPorts         []*deviceTypeComponentDefinition `yaml:"power-ports,omitempty"`
+	PowerOutlets       []*deviceTypeComponentDefinition `yaml:"power-outlets,omitempty"`
+	RearPorts          []*deviceTypeComponentDefinition `yaml:"rear-ports,omitempty"`
+	FrontPorts         []*deviceTypeComponentDefinition `yaml:"front-ports,omitempty"`
+	ModuleBays         []*deviceTypeComponentDefinition `yaml:"module-bays,omitempty"`
+	DeviceBays         []*deviceTypeComponentDefinition `yaml:"device-bays,omitempty"`
+}
+
+// deviceTypeComponentDefinition is a component template of a device type definition. It holds the attributes of all
+// kinds of component templates, only the ones applicable to the kind at hand are used.
+type deviceTypeComponentDefinition struct {
+	Name             string `yaml:"name"`
+	Label            string `yaml:"label,omitempty"`
+	Type             string `yaml:"type,omitempty"`
+	MgmtOnly         bool   `yaml:"mgmt_only,omitempty"`
+	MaximumDraw      *int64 `yaml:"maximum_draw,omitempty"`
+	AllocatedDraw    *int64 `yaml:"allocated_draw,omitempty"`
+	PowerPort        string `yaml:"power_port,omitempty"`
+	FeedLeg          string `yaml:"feed_leg,omitempty"`
+	RearPort         string `yaml:"rear_port,omitempty"`
+	RearPortPosition int64  `yaml:"rear_port_position,omitempty"`
+	Positions        int64  `yaml:"positions,omitempty"`
+	Color            string `yaml:"color,omitempty"`
+	Position         string `yaml:"position,omitempty"`
+	Description      string `yaml:"description,omitempty"`
+}
+
+// deviceTypeComponentKind describes how a kind of component template is stored in a device type definition and
+// in Netbox
+type deviceTypeComponentKind struct {
+	key        string
+	path       string
+	components func(definition *deviceTypeDefinition) *[]*deviceTypeComponentDefinition
+	// body returns the API representation of a component template without its device type. ids maps the names of
+	// already reconciled power port and rear port templates to their IDs.
+	body func(component *deviceTypeComponentDefinition, ids deviceTypeComponentIDs) (map[string]interface{}, error)
+}
+
+// deviceTypeComponentIDs maps kind keys and component names to the IDs of the component templates in Netbox
+type deviceTypeComponentIDs map[string]map[string]int64
+
+func (ids deviceTypeComponentIDs) lookup(key, name string) (interface{}, error) {
+	if name == "" {
+		return nil, nil
+	}
+	id, ok := ids[key][name]
+	if !ok {
+		return nil, fmt.Errorf("%s %q is not part of the device type definition", strings.TrimSuffix(key, "s"), name)
+	}
+	return id, nil
+}
+
+func deviceTypeComponentBaseBody(component *deviceTypeComponentDefinition) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        component.Name,
+		"label":       component.Label,
+		"description": component.Description,
+	}
+}
+
+// deviceTypeComponentKinds lists all kinds of component templates in the order they have to be created in.
+// Power outlets reference power ports and front ports reference rear ports, so those have to be deleted in reverse
+// order.
+var deviceTypeComponentKinds = []deviceTypeComponentKind{
+	{
+		key:  "interfaces",
+		path: "/dcim/interface-templates/",
+		components: func(definition *deviceTypeDefinition) *[]*deviceTypeComponentDefinition {
+			return &definition.Interfaces
+		},
+		body: func(component *deviceTypeComponentDefinition, ids deviceTypeComponentIDs) (map[string]interface{}, error) {
+			body := deviceTypeComponentBaseBody(component)
+			body["type"] = component.Type
+			body["mgmt_only"] = component.MgmtOnly
+			return body, nil
+		},
+	},
+	{
+		key:  "console-ports",
+		path: "/dcim/console-port-templates/",
+		components: func(definition *deviceTypeDefinition) *[]*deviceTypeComponentDefinition {
+			return &definition.ConsolePorts
+		},
+		body: func(component *deviceTypeComponentDefinition, ids deviceTypeComponentIDs) (map[string]interface{}, error) {
+			body := deviceTypeComponentBaseBody(component)
+			body["type"] = component.Type
+			return body, nil
+		},
+	},
+	{
+		key:  "console-server-ports",
+		path: "/dcim/console-server-port-templates/",
+		components: func(definition *deviceTypeDefinition) *[]*deviceTypeComponentDefinition {
+			return &definition.ConsoleServerPorts
+		},
+		body: func(component *deviceTypeComponentDefinition, ids deviceTypeComponentIDs) (map[string]interface{}, error) {
+			body := deviceTypeComponentBaseBody(component)
+			body["type"] = component.Type
+			return body, nil
+		},
+	},
+	{
+		key:  "power-ports",
+		path: "/dcim/power-port-templates/",
+		components: func(definition *deviceTypeDefinition) *[]*deviceTypeComponentDefinition {
+			return &definition.PowerPorts
+		},
+		body: func(component *deviceTypeComponentDefinition, ids deviceTypeComponentIDs) (map[string]interface{}, error) {
+			body := deviceTypeComponentBaseBody(component)
+			body["type"] = component.Type
+			body["maximum_draw"] = component.MaximumDraw
+			body["allocated_draw"] = component.AllocatedDraw
+			return body, nil
+		},
+	},
+	{
+		key:  "power-outlets",
+		path: "/dcim/power-outlet-templates/",
+		components: func(definition *deviceTypeDefinition) *[]*deviceTypeComponentDefinition {
+			return &definition.PowerOutlets
+		},
+		body: func(component *deviceTypeComponentDefinition, ids deviceTypeComponentIDs) (map[string]interface{}, error) {
+			powerPort, err := ids.lookup("power-ports", component.PowerPort)
+			if err != nil {
+				return nil, err
+			}
+			body := deviceTypeComponentBaseBody(component)
+			body["type"] = component.Type
+			body["power_port"] = powerPort
+			body["feed_leg"] = component.FeedLeg
+			return body, nil
+		},
+	},
+	{
+		key:  "rear-ports",
+		path: "/dcim/rear-port-templates/",
+		components: func(definition *deviceTypeDefinition) *[]*deviceTypeComponentDefinition {
+			return &definition.RearPorts
+		},
+		body: func(component *deviceTypeComponentDefinition, ids deviceTypeComponentIDs) (map[string]interface{}, error) {
+			body := deviceTypeComponentBaseBody(component)
+			body["type"] = component.Type
+			body["positions"] = component.Positions
+			body["color"] = component.Color
+			return body, nil
+		},
+	},
+	{
+		key:  "front-ports",
+		path: "/dcim/front-port-templates/",
+		components: func(definition *deviceTypeDefinition) *[]*deviceTypeComponentDefinition {
+			return &definition.FrontPorts
+		},
+		body: func(component *deviceTypeComponentDefinition, ids deviceTypeComponentIDs) (map[string]interface{}, error) {
+			rearPort, err := ids.lookup("rear-ports", component.RearPort)
+			if err != nil {
+				return nil, err
+			}
+			body := deviceTypeComponentBaseBody(component)
+			body["type"] = component.Type
+			body["rear_port"] = rearPort
+			body["rear_port_position"] = component.RearPortPosition
+			body["color"] = component.Color
+			return body, nil
+		},
+	},
+	{
+		key:  "module-bays",
+		path: "/dcim/module-bay-templates/",
+		components: func(definition *deviceTypeDefinition) *[]*deviceTypeComponentDefinition {
+			return &definition.ModuleBays
+		},
+		body: func(component *deviceTypeComponentDefinition, ids deviceTypeComponentIDs) (map[string]interface{}, error) {
+			body := deviceTypeComponentBaseBody(component)
+			body["position"] = component.Position
+			return body, nil
+		},
+	},
+	{
+		key:  "device-bays",
+		path: "/dcim/device-bay-templates/",
+		components: func(definition *deviceTypeDefinition) *[]*deviceTypeComponentDefinition {
+			return &definition.DeviceBays
+		},
+		body: func(component *deviceTypeComponentDefinition, ids deviceTypeComponentIDs) (map[string]interface{}, error) {
+			return deviceTypeComponentBaseBody(component), nil
+		},
+	},
+}
+
+// parseDeviceTypeDefinition decodes a device type definition in YAML (or JSON) format and normalizes it
+func parseDeviceTypeDefinition(value string) (*deviceTypeDefinition, error) {
+	var definition deviceTypeDefinition
+	if err := yaml.Unmarshal([]byte(value), &definition); err != nil {
+		return nil, fmt.Errorf("failed to decode device type definition: %w", err)
+	}
+	if definition.Manufacturer == "" {
+		return nil, fmt.Errorf("device type definition has no manufacturer")
+	}
+	if definition.Model == "" {
+		return nil, fmt.Errorf("device type definition has no model")
+	}
+
+	for _, kind := range deviceTypeComponentKinds {
+		names := map[string]bool{}
+		for _, component := range *kind.components(&definition) {
+			if component == nil || component.Name == "" {
+				return nil, fmt.Errorf("device type definition has %s without a name", kind.key)
+			}
+			if names[component.Name] {
+				return nil, fmt.Errorf("device type definition has more than one of %s named %q", kind.key, component.Name)
+			}
+			names[component.Name] = true
+		}
+	}
+
+	definition.normalize()
+	return &definition, nil
+}
+
+// normalize applies the defaults of Netbox and sorts all components by name, so that equivalent definitions are
+// equal
+func (definition *deviceTypeDefinition) normalize() {
+	if definition.Slug == "" {
+		definition.Slug = getSlug(definition.Model)
+	}
+	if definition.UHeight == nil {
+		uHeight := 1.0
+		definition.UHeight = &uHeight
+	}
+	if definition.IsFullDepth == nil {
+		isFullDepth := true
+		definition.IsFullDepth = &isFullDepth
+	}
+
+	for _, component := range definition.RearPorts {
+		if component.Positions == 0 {
+			component.Positions = 1
+		}
+	}
+	for _, component := range definition.FrontPorts {
+		if component.RearPortPosition == 0 {
+			component.RearPortPosition = 1
+		}
+	}
+	for _, kind := range deviceTypeComponentKinds {
+		components := *kind.components(definition)
+		sort.SliceStable(components, func(i, j int) bool {
+			return components[i].Name < components[j].Name
+		})
+	}
+}
+
+// String returns the normalized YAML representation of the definition
+func (definition *deviceTypeDefinition) String() string {
+	out, err := yaml.Marshal(definition)
+	if err != nil {
+		return ""
+	}
+	return string(out)
+}
+
+// equalDeviceTypeDefinitions returns true if both values are valid and describe the same device type
+func equalDeviceTypeDefinitions(a, b string) bool {
+	definitionA, err := parseDeviceTypeDefinition(a)
+	if err != nil {
+		return false
+	}
+	definitionB, err := parseDeviceTypeDefinition(b)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(definitionA, definitionB)
+}
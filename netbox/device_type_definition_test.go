@@ -0,0 +1,136 @@
+package netbox
+
+import (
+	"testing"
+)
+
+const testDeviceTypeDefinition = `---
+manufacturer: Acme
+model: Switch 48
+slug: acme-switch-48
+part_number: SW48
+u_height: 1
+is_full_depth: false
+airflow: front-to-rear
+comments: '[Datasheet](https://example.com)'
+interfaces:
+  - name: eth2
+    type: 1000base-t
+  - name: eth1
+    type: 1000base-t
+  - name: mgmt
+    type: 1000base-t
+    mgmt_only: true
+console-ports:
+  - name: con0
+    type: rj-45
+power-ports:
+  - name: PSU1
+    type: iec-60320-c14
+    maximum_draw: 150
+rear-ports:
+  - name: rear
+    type: 8p8c
+front-ports:
+  - name: front
+    type: 8p8c
+    rear_port: rear
+module-bays:
+  - name: slot1
+    position: 1
+inventory-items:
+  - name: fan
+`
+
+func TestParseDeviceTypeDefinition(t *testing.T) {
+	definition, err := parseDeviceTypeDefinition(testDeviceTypeDefinition)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *definition.UHeight != 1 || *definition.IsFullDepth {
+		t.Fatalf("expected u_height 1 and is_full_depth false, got %v and %v", *definition.UHeight, *definition.IsFullDepth)
+	}
+	if len(definition.Interfaces) != 3 || definition.Interfaces[0].Name != "eth1" || definition.Interfaces[2].Name != "mgmt" {
+		t.Fatalf("expected interfaces to be sorted by name, got %+v", definition.Interfaces)
+	}
+	if !definition.Interfaces[2].MgmtOnly {
+		t.Fatal("expected mgmt interface to be management only")
+	}
+	if definition.RearPorts[0].Positions != 1 || definition.FrontPorts[0].RearPortPosition != 1 {
+		t.Fatal("expected rear port positions to default to 1")
+	}
+	if definition.ModuleBays[0].Position != "1" {
+		t.Fatalf("expected module bay position 1, got %q", definition.ModuleBays[0].Position)
+	}
+}
+
+func TestParseDeviceTypeDefinitionDefaults(t *testing.T) {
+	definition, err := parseDeviceTypeDefinition(`{"manufacturer": "Acme", "model": "Switch 24"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if definition.Slug != "switch-24" {
+		t.Fatalf("expected slug to default to switch-24, got %q", definition.Slug)
+	}
+	if *definition.UHeight != 1 || !*definition.IsFullDepth {
+		t.Fatal("expected u_height and is_full_depth to default to 1 and true")
+	}
+}
+
+func TestParseDeviceTypeDefinitionErrors(t *testing.T) {
+	for name, value := range map[string]string{
+		"NoManufacturer":   "model: Switch\n",
+		"NoModel":          "manufacturer: Acme\n",
+		"Invalid":          "manufacturer: [Acme\n",
+		"UnnamedComponent": "manufacturer: Acme\nmodel: Switch\ninterfaces:\n  - type: 1000base-t\n",
+		"DuplicateName":    "manufacturer: Acme\nmodel: Switch\ninterfaces:\n  - name: eth0\n  - name: eth0\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := parseDeviceTypeDefinition(value); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestEqualDeviceTypeDefinitions(t *testing.T) {
+	definition, err := parseDeviceTypeDefinition(testDeviceTypeDefinition)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !equalDeviceTypeDefinitions(testDeviceTypeDefinition, definition.String()) {
+		t.Fatalf("expected the normalized definition to be equal, got:\n%s", definition.String())
+	}
+
+	definition.Interfaces[0].Type = "10gbase-t"
+	if equalDeviceTypeDefinitions(testDeviceTypeDefinition, definition.String()) {
+		t.Fatal("expected definitions with different interface types to differ")
+	}
+
+	if equalDeviceTypeDefinitions(testDeviceTypeDefinition, "") {
+		t.Fatal("expected an empty definition to differ")
+	}
+}
+
+func TestDeviceTypeComponentKindBody(t *testing.T) {
+	ids := deviceTypeComponentIDs{"rear-ports": {"rear": 42}}
+	for _, kind := range deviceTypeComponentKinds {
+		if kind.key != "front-ports" {
+			continue
+		}
+		body, err := kind.body(&deviceTypeComponentDefinition{Name: "front", Type: "8p8c", RearPort: "rear", RearPortPosition: 1}, ids)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if body["rear_port"] != int64(42) {
+			t.Fatalf("expected rear port 42, got %v", body["rear_port"])
+		}
+
+		_, err = kind.body(&deviceTypeComponentDefinition{Name: "front", Type: "8p8c", RearPort: "missing"}, ids)
+		if err == nil {
+			t.Fatal("expected an error for an unknown rear port")
+		}
+	}
+}
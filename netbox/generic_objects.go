@@ -1,33 +1,57 @@
 package netbox
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// genericObjectCableTerminationTypes are the object types that can be terminated by a cable. Provider networks are
+// not, they are attached to circuit terminations instead.
+var genericObjectCableTerminationTypes = []string{
+	"circuits.circuittermination",
+	"dcim.consoleport",
+	"dcim.consoleserverport",
+	"dcim.frontport",
+	"dcim.interface",
+	"dcim.powerfeed",
+	"dcim.poweroutlet",
+	"dcim.powerport",
+	"dcim.rearport",
+}
+
+// genericObjectCompatibleCableTerminationTypes maps each cable termination type to the
+// types it can be connected to on the other end of a cable, mirroring Netbox' own rules.
+var genericObjectCompatibleCableTerminationTypes = map[string][]string{
+	"circuits.circuittermination": {"dcim.interface", "dcim.frontport", "dcim.rearport", "circuits.circuittermination"},
+	"dcim.consoleport":            {"dcim.consoleserverport", "dcim.frontport", "dcim.rearport"},
+	"dcim.consoleserverport":      {"dcim.consoleport", "dcim.frontport", "dcim.rearport"},
+	"dcim.interface":              {"dcim.interface", "circuits.circuittermination", "dcim.frontport", "dcim.rearport"},
+	"dcim.frontport":              {"dcim.consoleport", "dcim.consoleserverport", "dcim.interface", "dcim.frontport", "dcim.rearport", "circuits.circuittermination"},
+	"dcim.rearport":               {"dcim.consoleport", "dcim.consoleserverport", "dcim.interface", "dcim.frontport", "dcim.rearport", "circuits.circuittermination"},
+	"dcim.powerfeed":              {"dcim.powerport"},
+	"dcim.poweroutlet":            {"dcim.powerport"},
+	"dcim.powerport":              {"dcim.poweroutlet", "dcim.powerfeed"},
+}
+
+// genericObjectSchema references an object that can be terminated by a cable
 var genericObjectSchema = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"object_type": {
-			Type:     schema.TypeString,
-			Required: true,
-			ValidateFunc: validation.StringInSlice([]string{
-				// known supported generic objects (wherever the schema is used)
-				// can be made into a parameter when importing this schema if desired
-				"dcim.powerport",
-				"dcim.poweroutlet",
-				"dcim.powerfeed",
-				"dcim.frontport",
-				"dcim.rearport",
-				"dcim.consoleserverport",
-				"dcim.consoleport",
-				"dcim.interface",
-				"circuits.circuittermination",
-			}, false),
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(genericObjectCableTerminationTypes, false),
+			Description:  buildValidValueDescription(genericObjectCableTerminationTypes),
 		},
 		"object_id": {
-			Type:     schema.TypeInt,
-			Required: true,
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
 		},
 	},
 }
@@ -54,3 +78,55 @@ func getSchemaSetFromGenericObjects(objects []*models.GenericObject) []map[strin
 	}
 	return retArr
 }
+
+// getGenericObjectTypesFromRawConfig returns the distinct object types in the raw configuration of a set of
+// generic objects, sorted. Object types that are not known yet are skipped.
+func getGenericObjectTypesFromRawConfig(v cty.Value) []string {
+	seen := make(map[string]bool)
+	types := []string{}
+	if v.IsNull() || !v.IsKnown() {
+		return types
+	}
+	for it := v.ElementIterator(); it.Next(); {
+		_, element := it.Element()
+		if element.IsNull() || !element.IsKnown() {
+			continue
+		}
+		objectType := element.GetAttr("object_type")
+		if objectType.IsNull() || !objectType.IsKnown() || seen[objectType.AsString()] {
+			continue
+		}
+		seen[objectType.AsString()] = true
+		types = append(types, objectType.AsString())
+	}
+	sort.Strings(types)
+	return types
+}
+
+// validateCableTerminationTypes checks that all A terminations and all B terminations share a
+// type each and that these types can be connected by a cable.
+func validateCableTerminationTypes(aTypes, bTypes []string) error {
+	if len(aTypes) > 1 {
+		return fmt.Errorf("all terminations in a_termination must be of the same object_type, got %s", strings.Join(aTypes, ", "))
+	}
+	if len(bTypes) > 1 {
+		return fmt.Errorf("all terminations in b_termination must be of the same object_type, got %s", strings.Join(bTypes, ", "))
+	}
+	for _, t := range append(append([]string{}, aTypes...), bTypes...) {
+		if _, ok := genericObjectCompatibleCableTerminationTypes[t]; !ok {
+			return fmt.Errorf("a %s cannot be terminated by a cable", t)
+		}
+	}
+	if len(aTypes) == 0 || len(bTypes) == 0 {
+		return nil
+	}
+
+	aType, bType := aTypes[0], bTypes[0]
+	compatible := genericObjectCompatibleCableTerminationTypes[aType]
+	for _, t := range compatible {
+		if t == bType {
+			return nil
+		}
+	}
+	return fmt.Errorf("a %s cannot be connected to a %s, compatible types are %s", aType, bType, strings.Join(compatible, ", "))
+}
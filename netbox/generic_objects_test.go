@@ -0,0 +1,130 @@
+package netbox
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestValidateCableTerminationTypes(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		aTypes  []string
+		bTypes  []string
+		wantErr bool
+	}{
+		{
+			name:   "ConsoleToConsoleServer",
+			aTypes: []string{"dcim.consoleport"},
+			bTypes: []string{"dcim.consoleserverport"},
+		},
+		{
+			name:   "PowerFeedToPowerPort",
+			aTypes: []string{"dcim.powerfeed"},
+			bTypes: []string{"dcim.powerport"},
+		},
+		{
+			name:   "InterfaceToFrontPort",
+			aTypes: []string{"dcim.interface"},
+			bTypes: []string{"dcim.frontport"},
+		},
+		{
+			name:    "PowerPortToInterface",
+			aTypes:  []string{"dcim.powerport"},
+			bTypes:  []string{"dcim.interface"},
+			wantErr: true,
+		},
+		{
+			name:    "ConsolePortToConsolePort",
+			aTypes:  []string{"dcim.consoleport"},
+			bTypes:  []string{"dcim.consoleport"},
+			wantErr: true,
+		},
+		{
+			name:    "MixedSide",
+			aTypes:  []string{"dcim.consoleport", "dcim.interface"},
+			bTypes:  []string{"dcim.frontport"},
+			wantErr: true,
+		},
+		{
+			name:    "ProviderNetwork",
+			aTypes:  []string{"circuits.providernetwork"},
+			bTypes:  []string{"dcim.interface"},
+			wantErr: true,
+		},
+		{
+			name:    "ContactAssignmentType",
+			aTypes:  []string{"dcim.interface"},
+			bTypes:  []string{"dcim.site"},
+			wantErr: true,
+		},
+		{
+			name:   "CircuitTerminationToInterface",
+			aTypes: []string{"circuits.circuittermination"},
+			bTypes: []string{"dcim.interface"},
+		},
+		{
+			name:   "EmptySide",
+			aTypes: []string{"dcim.powerport"},
+			bTypes: []string{},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCableTerminationTypes(tt.aTypes, tt.bTypes)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error: %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetGenericObjectTypesFromRawConfig(t *testing.T) {
+	genericObject := func(objectType cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"object_type": objectType,
+			// References to objects created in the same apply
+			"object_id": cty.UnknownVal(cty.Number),
+		})
+	}
+	objectType := cty.Object(map[string]cty.Type{"object_type": cty.String, "object_id": cty.Number})
+
+	for _, tt := range []struct {
+		name     string
+		value    cty.Value
+		expected []string
+	}{
+		{
+			name:     "UnknownIDs",
+			value:    cty.SetVal([]cty.Value{genericObject(cty.StringVal("dcim.interface")), genericObject(cty.StringVal("dcim.interface"))}),
+			expected: []string{"dcim.interface"},
+		},
+		{
+			name:     "Mixed",
+			value:    cty.SetVal([]cty.Value{genericObject(cty.StringVal("dcim.rearport")), genericObject(cty.StringVal("dcim.frontport"))}),
+			expected: []string{"dcim.frontport", "dcim.rearport"},
+		},
+		{
+			name:     "UnknownType",
+			value:    cty.SetVal([]cty.Value{genericObject(cty.UnknownVal(cty.String))}),
+			expected: []string{},
+		},
+		{
+			name:     "Unknown",
+			value:    cty.UnknownVal(cty.Set(objectType)),
+			expected: []string{},
+		},
+		{
+			name:     "Null",
+			value:    cty.NullVal(cty.Set(objectType)),
+			expected: []string{},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := getGenericObjectTypesFromRawConfig(tt.value)
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Fatalf("got %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package netbox
+
+import (
+	"math"
+	"math/big"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// prefixSize returns the number of addresses in the given prefix
+func prefixSize(prefix netip.Prefix) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(prefix.Addr().BitLen()-prefix.Bits()))
+}
+
+// addrToBig returns the numeric value of the given address
+func addrToBig(addr netip.Addr) *big.Int {
+	b := addr.AsSlice()
+	return new(big.Int).SetBytes(b)
+}
+
+// bigToAddr returns the address with the given numeric value in the family of like
+func bigToAddr(value *big.Int, like netip.Addr) netip.Addr {
+	b := make([]byte, like.BitLen()/8)
+	value.FillBytes(b)
+	addr, _ := netip.AddrFromSlice(b)
+	return addr
+}
+
+// ipRangeSize returns the number of addresses between start and end, both included
+func ipRangeSize(start, end netip.Addr) *big.Int {
+	size := new(big.Int).Sub(addrToBig(end), addrToBig(start))
+	return size.Add(size, big.NewInt(1))
+}
+
+// ipRangeToPrefixes returns the smallest list of prefixes that exactly covers the addresses between start and end
+func ipRangeToPrefixes(start, end netip.Addr) []netip.Prefix {
+	var prefixes []netip.Prefix
+	bitLen := start.BitLen()
+	current := addrToBig(start)
+	last := addrToBig(end)
+	for current.Cmp(last) <= 0 {
+		// Use the largest block that is aligned to the current address and does not exceed the end of the range
+		hostBits := 0
+		for hostBits < bitLen && current.Bit(hostBits) == 0 {
+			next := new(big.Int).Lsh(big.NewInt(1), uint(hostBits+1))
+			next.Add(next, current).Sub(next, big.NewInt(1))
+			if next.Cmp(last) > 0 {
+				break
+			}
+			hostBits++
+		}
+		prefixes = append(prefixes, netip.PrefixFrom(bigToAddr(current, start), bitLen-hostBits))
+		current.Add(current, new(big.Int).Lsh(big.NewInt(1), uint(hostBits)))
+	}
+	return prefixes
+}
+
+// splitPrefixes returns the first limit prefixes of the given length that fit into the given prefixes and the total
+// number of prefixes of that length that fit. Prefixes smaller than length are ignored.
+func splitPrefixes(prefixes []netip.Prefix, length int, limit int) ([]netip.Prefix, *big.Int) {
+	var result []netip.Prefix
+	total := new(big.Int)
+	for _, prefix := range prefixes {
+		if prefix.Bits() > length || length > prefix.Addr().BitLen() {
+			continue
+		}
+		count := new(big.Int).Lsh(big.NewInt(1), uint(length-prefix.Bits()))
+		total.Add(total, count)
+
+		step := new(big.Int).Lsh(big.NewInt(1), uint(prefix.Addr().BitLen()-length))
+		current := addrToBig(prefix.Addr())
+		for i := int64(0); len(result) < limit && count.Cmp(big.NewInt(i)) > 0; i++ {
+			result = append(result, netip.PrefixFrom(bigToAddr(current, prefix.Addr()), length))
+			current.Add(current, step)
+		}
+	}
+	return result, total
+}
+
+// bigToInt caps the given value at the largest int, IPv6 prefixes easily contain more addresses than that
+func bigToInt(value *big.Int) int {
+	if !value.IsInt64() || value.Int64() > math.MaxInt {
+		return math.MaxInt
+	}
+	return int(value.Int64())
+}
+
+// capacitySchema returns the attributes that describe the capacity of a prefix or IP range, unit names what is
+// counted
+func capacitySchema(unit string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"size": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The total number of " + unit + ". Values that do not fit into a number are capped at the largest number.",
+		},
+		"used": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of " + unit + " in use.",
+		},
+		"free": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of " + unit + " not in use.",
+		},
+		"utilization": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The percentage of " + unit + " in use.",
+		},
+	}
+}
+
+// capacityValues returns size, used, free and utilization as they are stored in state. used is capped at size.
+func capacityValues(size, used *big.Int) (int, int, int, float64) {
+	if used.Cmp(size) > 0 {
+		used = size
+	}
+	free := new(big.Int).Sub(size, used)
+
+	utilization := 0.0
+	if size.Sign() > 0 {
+		utilization, _ = new(big.Rat).SetFrac(new(big.Int).Mul(used, big.NewInt(100)), size).Float64()
+	}
+	return bigToInt(size), bigToInt(used), bigToInt(free), utilization
+}
+
+// setCapacity sets the attributes of capacitySchema. used is capped at size.
+func setCapacity(d *schema.ResourceData, size, used *big.Int) {
+	sizeValue, usedValue, freeValue, utilization := capacityValues(size, used)
+	d.Set("size", sizeValue)
+	d.Set("used", usedValue)
+	d.Set("free", freeValue)
+	d.Set("utilization", utilization)
+}
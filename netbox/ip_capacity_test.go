@@ -0,0 +1,119 @@
+package netbox
+
+import (
+	"math"
+	"math/big"
+	"net/netip"
+	"reflect"
+	"testing"
+)
+
+func TestIPRangeToPrefixes(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		start    string
+		end      string
+		expected []string
+	}{
+		{
+			name:     "SingleAddress",
+			start:    "10.0.0.5",
+			end:      "10.0.0.5",
+			expected: []string{"10.0.0.5/32"},
+		},
+		{
+			name:     "AlignedBlock",
+			start:    "10.0.0.0",
+			end:      "10.0.0.255",
+			expected: []string{"10.0.0.0/24"},
+		},
+		{
+			name:     "Unaligned",
+			start:    "10.0.0.10",
+			end:      "10.0.0.20",
+			expected: []string{"10.0.0.10/31", "10.0.0.12/30", "10.0.0.16/30", "10.0.0.20/32"},
+		},
+		{
+			name:     "IPv6",
+			start:    "2001:db8::1",
+			end:      "2001:db8::4",
+			expected: []string{"2001:db8::1/128", "2001:db8::2/127", "2001:db8::4/128"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var actual []string
+			for _, prefix := range ipRangeToPrefixes(netip.MustParseAddr(tt.start), netip.MustParseAddr(tt.end)) {
+				actual = append(actual, prefix.String())
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSplitPrefixes(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		prefixes      []string
+		length        int
+		limit         int
+		expected      []string
+		expectedTotal int64
+	}{
+		{
+			name:          "Split",
+			prefixes:      []string{"10.0.0.0/26", "10.0.1.0/28"},
+			length:        28,
+			limit:         10,
+			expected:      []string{"10.0.0.0/28", "10.0.0.16/28", "10.0.0.32/28", "10.0.0.48/28", "10.0.1.0/28"},
+			expectedTotal: 5,
+		},
+		{
+			name:          "SkipSmaller",
+			prefixes:      []string{"10.0.0.0/29", "10.0.1.0/27"},
+			length:        28,
+			limit:         10,
+			expected:      []string{"10.0.1.0/28", "10.0.1.16/28"},
+			expectedTotal: 2,
+		},
+		{
+			name:          "Limit",
+			prefixes:      []string{"10.0.0.0/22"},
+			length:        28,
+			limit:         2,
+			expected:      []string{"10.0.0.0/28", "10.0.0.16/28"},
+			expectedTotal: 64,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var prefixes []netip.Prefix
+			for _, prefix := range tt.prefixes {
+				prefixes = append(prefixes, netip.MustParsePrefix(prefix))
+			}
+			result, total := splitPrefixes(prefixes, tt.length, tt.limit)
+			var actual []string
+			for _, prefix := range result {
+				actual = append(actual, prefix.String())
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", tt.expected, actual)
+			}
+			if total.Int64() != tt.expectedTotal {
+				t.Fatalf("expected a total of %d, got %s", tt.expectedTotal, total)
+			}
+		})
+	}
+}
+
+func TestBigToInt(t *testing.T) {
+	if actual := bigToInt(prefixSize(netip.MustParsePrefix("10.0.0.0/22"))); actual != 1024 {
+		t.Fatalf("expected 1024, got %d", actual)
+	}
+	if actual := bigToInt(prefixSize(netip.MustParsePrefix("2001:db8::/32"))); actual != math.MaxInt {
+		t.Fatalf("expected %d, got %d", math.MaxInt, actual)
+	}
+	if actual := ipRangeSize(netip.MustParseAddr("10.0.0.10"), netip.MustParseAddr("10.0.0.20")); actual.Cmp(big.NewInt(11)) != 0 {
+		t.Fatalf("expected 11, got %s", actual)
+	}
+}
@@ -0,0 +1,57 @@
+package netbox
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// normalizeCIDR returns the canonical notation of an address or prefix in CIDR notation, e.g. 2001:db8::1/64 for
+// 2001:DB8:0::1/64. Values that cannot be parsed are returned as they are.
+func normalizeCIDR(value string) string {
+	prefix, err := netip.ParsePrefix(strings.TrimSpace(value))
+	if err != nil {
+		return value
+	}
+	return prefix.String()
+}
+
+// suppressEquivalentCIDRDiff is a DiffSuppressFunc that ignores differences between notations of the same address
+// or prefix in CIDR notation
+func suppressEquivalentCIDRDiff(k, old, new string, d *schema.ResourceData) bool {
+	return old != "" && new != "" && normalizeCIDR(old) == normalizeCIDR(new)
+}
+
+// validateIPAddressWithMask is a ValidateFunc for IP addresses in CIDR notation, e.g. 192.0.2.1/24. Host bits may be
+// set.
+func validateIPAddressWithMask(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if _, err := netip.ParsePrefix(strings.TrimSpace(v)); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be an IP address in CIDR notation (e.g. 192.0.2.1/24), got %q: %w", k, v, err)}
+	}
+	return nil, nil
+}
+
+// validatePrefix is a ValidateFunc for prefixes in CIDR notation, e.g. 192.0.2.0/24. Host bits must not be set, as
+// Netbox would silently clear them.
+func validatePrefix(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	prefix, err := netip.ParsePrefix(strings.TrimSpace(v))
+	if err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a prefix in CIDR notation (e.g. 192.0.2.0/24), got %q: %w", k, v, err)}
+	}
+	if prefix.Masked() != prefix {
+		return nil, []error{fmt.Errorf("expected %s to be a prefix without host bits set, got %q, did you mean %q?", k, v, prefix.Masked().String())}
+	}
+	return nil, nil
+}
@@ -0,0 +1,107 @@
+package netbox
+
+import (
+	"testing"
+)
+
+func TestSuppressEquivalentCIDRDiff(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		old      string
+		new      string
+		expected bool
+	}{
+		{
+			name:     "Identical",
+			old:      "192.0.2.1/24",
+			new:      "192.0.2.1/24",
+			expected: true,
+		},
+		{
+			name:     "IPv6Notation",
+			old:      "2001:db8::1/64",
+			new:      "2001:DB8:0::1/64",
+			expected: true,
+		},
+		{
+			name:     "IPv6LeadingZeros",
+			old:      "2001:db8::/48",
+			new:      "2001:0db8:0000::/48",
+			expected: true,
+		},
+		{
+			name:     "DifferentAddress",
+			old:      "192.0.2.1/24",
+			new:      "192.0.2.2/24",
+			expected: false,
+		},
+		{
+			name:     "DifferentLength",
+			old:      "192.0.2.1/24",
+			new:      "192.0.2.1/25",
+			expected: false,
+		},
+		{
+			name:     "Create",
+			old:      "",
+			new:      "192.0.2.1/24",
+			expected: false,
+		},
+		{
+			name:     "Invalid",
+			old:      "foo",
+			new:      "bar",
+			expected: false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := suppressEquivalentCIDRDiff("ip_address", tt.old, tt.new, nil)
+			if actual != tt.expected {
+				t.Fatalf("expected %t, got %t", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestValidateIPAddressWithMask(t *testing.T) {
+	for _, tt := range []struct {
+		value string
+		valid bool
+	}{
+		{value: "192.0.2.1/24", valid: true},
+		{value: "192.0.2.0/24", valid: true},
+		{value: "2001:DB8:0::1/64", valid: true},
+		{value: "192.0.2.1", valid: false},
+		{value: "192.0.2.1/33", valid: false},
+		{value: "foo", valid: false},
+	} {
+		t.Run(tt.value, func(t *testing.T) {
+			_, errs := validateIPAddressWithMask(tt.value, "ip_address")
+			if (len(errs) == 0) != tt.valid {
+				t.Fatalf("expected valid to be %t, got errors %v", tt.valid, errs)
+			}
+		})
+	}
+}
+
+func TestValidatePrefix(t *testing.T) {
+	for _, tt := range []struct {
+		value string
+		valid bool
+	}{
+		{value: "192.0.2.0/24", valid: true},
+		{value: "2001:db8::/32", valid: true},
+		{value: "2001:DB8::/32", valid: true},
+		{value: "192.0.2.1/24", valid: false},
+		{value: "2001:db8::1/64", valid: false},
+		{value: "192.0.2.0", valid: false},
+		{value: "foo", valid: false},
+	} {
+		t.Run(tt.value, func(t *testing.T) {
+			_, errs := validatePrefix(tt.value, "prefix")
+			if (len(errs) == 0) != tt.valid {
+				t.Fatalf("expected valid to be %t, got errors %v", tt.valid, errs)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package netbox
+
+import (
+	"errors"
+	"math"
+	"net/netip"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const prefixSelectorKey = "prefix_selector"
+
+var prefixSelectorSchema = &schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	MaxItems: 1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"role_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"tag": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Slug of a tag the prefixes have to carry.",
+			},
+			"site_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"vrf_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"is_pool": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, only prefixes marked as pool are selected.",
+			},
+			"min_free_percent": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatBetween(0, 100),
+				Description:  "Only selects prefixes of which at least the given percentage is not covered by child prefixes.",
+			},
+		},
+	},
+	Description: "Selects the candidate prefixes by their attributes. Prefixes have to match all given attributes and are tried in the order Netbox returns them (by VRF and prefix).",
+}
+
+// getPrefixIDsBySelector returns the IDs of all prefixes matching the given prefix_selector block
+func getPrefixIDsBySelector(api *client.NetBoxAPI, selector map[string]interface{}) ([]int64, error) {
+	params := ipam.NewIpamPrefixesListParams()
+	filtered := false
+	if roleID, ok := selector["role_id"].(int); ok && roleID != 0 {
+		params.RoleID = strToPtr(strconv.Itoa(roleID))
+		filtered = true
+	}
+	if tag, ok := selector["tag"].(string); ok && tag != "" {
+		params.Tag = []string{tag}
+		filtered = true
+	}
+	if siteID, ok := selector["site_id"].(int); ok && siteID != 0 {
+		params.SiteID = strToPtr(strconv.Itoa(siteID))
+		filtered = true
+	}
+	if vrfID, ok := selector["vrf_id"].(int); ok && vrfID != 0 {
+		params.VrfID = strToPtr(strconv.Itoa(vrfID))
+		filtered = true
+	}
+	if isPool, ok := selector["is_pool"].(bool); ok && isPool {
+		params.IsPool = strToPtr("true")
+		filtered = true
+	}
+	if !filtered {
+		return nil, errors.New("prefix_selector needs at least one attribute to select prefixes by")
+	}
+
+	limit := int64(1000)
+	offset := int64(0)
+	params.Limit = &limit
+	params.Offset = &offset
+
+	var ids []int64
+	for {
+		res, err := api.Ipam.IpamPrefixesList(params, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, prefix := range res.GetPayload().Results {
+			if minFree, ok := selector["min_free_percent"].(float64); ok && minFree > 0 {
+				free, err := getPrefixFreePercent(api, prefix.ID, *prefix.Prefix)
+				if err != nil {
+					return nil, err
+				}
+				if free < minFree {
+					continue
+				}
+			}
+			ids = append(ids, prefix.ID)
+		}
+
+		offset += int64(len(res.GetPayload().Results))
+		if len(res.GetPayload().Results) == 0 || offset >= *res.GetPayload().Count {
+			break
+		}
+	}
+	return ids, nil
+}
+
+// getPrefixFreePercent returns the percentage of the given prefix that is not covered by child prefixes
+func getPrefixFreePercent(api *client.NetBoxAPI, id int64, prefix string) (float64, error) {
+	parent, err := netip.ParsePrefix(prefix)
+	if err != nil {
+		return 0, err
+	}
+
+	params := ipam.NewIpamPrefixesAvailablePrefixesListParams().WithID(id)
+	res, err := api.Ipam.IpamPrefixesAvailablePrefixesList(params, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	free := 0.0
+	for _, available := range res.GetPayload() {
+		child, err := netip.ParsePrefix(available.Prefix)
+		if err != nil {
+			return 0, err
+		}
+		free += math.Ldexp(1, child.Addr().BitLen()-child.Bits())
+	}
+	return free / math.Ldexp(1, parent.Addr().BitLen()-parent.Bits()) * 100, nil
+}
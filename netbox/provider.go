@@ -73,123 +73,168 @@ func init() {
 func Provider() *schema.Provider {
 	provider := &schema.Provider{
 		ResourcesMap: map[string]*schema.Resource{
-			"netbox_available_ip_address":       resourceNetboxAvailableIPAddress(),
-			"netbox_virtual_machine":            resourceNetboxVirtualMachine(),
-			"netbox_cluster_type":               resourceNetboxClusterType(),
-			"netbox_cluster":                    resourceNetboxCluster(),
-			"netbox_contact":                    resourceNetboxContact(),
-			"netbox_contact_group":              resourceNetboxContactGroup(),
-			"netbox_contact_assignment":         resourceNetboxContactAssignment(),
-			"netbox_contact_role":               resourceNetboxContactRole(),
-			"netbox_device":                     resourceNetboxDevice(),
-			"netbox_device_interface":           resourceNetboxDeviceInterface(),
-			"netbox_device_type":                resourceNetboxDeviceType(),
-			"netbox_manufacturer":               resourceNetboxManufacturer(),
-			"netbox_tenant":                     resourceNetboxTenant(),
-			"netbox_tenant_group":               resourceNetboxTenantGroup(),
-			"netbox_vrf":                        resourceNetboxVrf(),
-			"netbox_ip_address":                 resourceNetboxIPAddress(),
-			"netbox_interface_template":         resourceNetboxInterfaceTemplate(),
-			"netbox_interface":                  resourceNetboxInterface(),
-			"netbox_service":                    resourceNetboxService(),
-			"netbox_platform":                   resourceNetboxPlatform(),
-			"netbox_prefix":                     resourceNetboxPrefix(),
-			"netbox_available_prefix":           resourceNetboxAvailablePrefix(),
-			"netbox_primary_ip":                 resourceNetboxPrimaryIP(),
-			"netbox_device_primary_ip":          resourceNetboxDevicePrimaryIP(),
-			"netbox_device_role":                resourceNetboxDeviceRole(),
-			"netbox_tag":                        resourceNetboxTag(),
-			"netbox_cluster_group":              resourceNetboxClusterGroup(),
-			"netbox_site":                       resourceNetboxSite(),
-			"netbox_vlan":                       resourceNetboxVlan(),
-			"netbox_vlan_group":                 resourceNetboxVlanGroup(),
-			"netbox_ipam_role":                  resourceNetboxIpamRole(),
-			"netbox_ip_range":                   resourceNetboxIPRange(),
-			"netbox_region":                     resourceNetboxRegion(),
-			"netbox_aggregate":                  resourceNetboxAggregate(),
-			"netbox_rir":                        resourceNetboxRir(),
-			"netbox_route_target":               resourceNetboxRouteTarget(),
-			"netbox_circuit":                    resourceNetboxCircuit(),
-			"netbox_circuit_type":               resourceNetboxCircuitType(),
-			"netbox_circuit_provider":           resourceNetboxCircuitProvider(),
-			"netbox_circuit_termination":        resourceNetboxCircuitTermination(),
-			"netbox_user":                       resourceNetboxUser(),
-			"netbox_group":                      resourceNetboxGroup(),
-			"netbox_permission":                 resourceNetboxPermission(),
-			"netbox_token":                      resourceNetboxToken(),
-			"netbox_custom_field":               resourceCustomField(),
-			"netbox_asn":                        resourceNetboxAsn(),
-			"netbox_location":                   resourceNetboxLocation(),
-			"netbox_site_group":                 resourceNetboxSiteGroup(),
-			"netbox_rack":                       resourceNetboxRack(),
-			"netbox_rack_role":                  resourceNetboxRackRole(),
-			"netbox_rack_reservation":           resourceNetboxRackReservation(),
-			"netbox_cable":                      resourceNetboxCable(),
-			"netbox_device_console_port":        resourceNetboxDeviceConsolePort(),
-			"netbox_device_console_server_port": resourceNetboxDeviceConsoleServerPort(),
-			"netbox_device_power_port":          resourceNetboxDevicePowerPort(),
-			"netbox_device_power_outlet":        resourceNetboxDevicePowerOutlet(),
-			"netbox_device_front_port":          resourceNetboxDeviceFrontPort(),
-			"netbox_device_rear_port":           resourceNetboxDeviceRearPort(),
-			"netbox_device_module_bay":          resourceNetboxDeviceModuleBay(),
-			"netbox_module":                     resourceNetboxModule(),
-			"netbox_module_type":                resourceNetboxModuleType(),
-			"netbox_power_feed":                 resourceNetboxPowerFeed(),
-			"netbox_power_panel":                resourceNetboxPowerPanel(),
-			"netbox_inventory_item_role":        resourceNetboxInventoryItemRole(),
-			"netbox_inventory_item":             resourceNetboxInventoryItem(),
-			"netbox_webhook":                    resourceNetboxWebhook(),
-			"netbox_custom_field_choice_set":    resourceNetboxCustomFieldChoiceSet(),
-			"netbox_virtual_chassis":            resourceNetboxVirtualChassis(),
-			"netbox_virtual_disk":               resourceNetboxVirtualDisks(),
-			"netbox_config_template":            resourceNetboxConfigTemplate(),
-			"netbox_event_rule":                 resourceNetboxEventRule(),
-			"netbox_vpn_tunnel_group":           resourceNetboxVpnTunnelGroup(),
-			"netbox_vpn_tunnel":                 resourceNetboxVpnTunnel(),
-			"netbox_vpn_tunnel_termination":     resourceNetboxVpnTunnelTermination(),
-			"netbox_config_context":             resourceNetboxConfigContext(),
+			"netbox_available_ip_address":         resourceNetboxAvailableIPAddress(),
+			"netbox_virtual_machine":              resourceNetboxVirtualMachine(),
+			"netbox_cluster_type":                 resourceNetboxClusterType(),
+			"netbox_cluster":                      resourceNetboxCluster(),
+			"netbox_contact":                      resourceNetboxContact(),
+			"netbox_contact_group":                resourceNetboxContactGroup(),
+			"netbox_contact_assignment":           resourceNetboxContactAssignment(),
+			"netbox_contact_role":                 resourceNetboxContactRole(),
+			"netbox_device":                       resourceNetboxDevice(),
+			"netbox_device_interface":             resourceNetboxDeviceInterface(),
+			"netbox_device_interfaces":            resourceNetboxDeviceInterfaces(),
+			"netbox_device_type":                  resourceNetboxDeviceType(),
+			"netbox_device_type_definition":       resourceNetboxDeviceTypeDefinition(),
+			"netbox_manufacturer":                 resourceNetboxManufacturer(),
+			"netbox_tenant":                       resourceNetboxTenant(),
+			"netbox_tenant_group":                 resourceNetboxTenantGroup(),
+			"netbox_vrf":                          resourceNetboxVrf(),
+			"netbox_fhrp_group":                   resourceNetboxFhrpGroup(),
+			"netbox_fhrp_group_assignment":        resourceNetboxFhrpGroupAssignment(),
+			"netbox_ip_address":                   resourceNetboxIPAddress(),
+			"netbox_interface_template":           resourceNetboxInterfaceTemplate(),
+			"netbox_console_port_template":        resourceNetboxConsolePortTemplate(),
+			"netbox_console_server_port_template": resourceNetboxConsoleServerPortTemplate(),
+			"netbox_power_port_template":          resourceNetboxPowerPortTemplate(),
+			"netbox_power_outlet_template":        resourceNetboxPowerOutletTemplate(),
+			"netbox_front_port_template":          resourceNetboxFrontPortTemplate(),
+			"netbox_rear_port_template":           resourceNetboxRearPortTemplate(),
+			"netbox_module_bay_template":          resourceNetboxModuleBayTemplate(),
+			"netbox_device_bay_template":          resourceNetboxDeviceBayTemplate(),
+			"netbox_interface":                    resourceNetboxInterface(),
+			"netbox_service":                      resourceNetboxService(),
+			"netbox_service_template":             resourceNetboxServiceTemplate(),
+			"netbox_platform":                     resourceNetboxPlatform(),
+			"netbox_prefix":                       resourceNetboxPrefix(),
+			"netbox_available_prefix":             resourceNetboxAvailablePrefix(),
+			"netbox_primary_ip":                   resourceNetboxPrimaryIP(),
+			"netbox_device_primary_ip":            resourceNetboxDevicePrimaryIP(),
+			"netbox_device_role":                  resourceNetboxDeviceRole(),
+			"netbox_tag":                          resourceNetboxTag(),
+			"netbox_cluster_group":                resourceNetboxClusterGroup(),
+			"netbox_site":                         resourceNetboxSite(),
+			"netbox_vlan":                         resourceNetboxVlan(),
+			"netbox_available_vlan":               resourceNetboxAvailableVlan(),
+			"netbox_vlan_group":                   resourceNetboxVlanGroup(),
+			"netbox_ipam_role":                    resourceNetboxIpamRole(),
+			"netbox_ip_range":                     resourceNetboxIPRange(),
+			"netbox_region":                       resourceNetboxRegion(),
+			"netbox_aggregate":                    resourceNetboxAggregate(),
+			"netbox_rir":                          resourceNetboxRir(),
+			"netbox_route_target":                 resourceNetboxRouteTarget(),
+			"netbox_circuit":                      resourceNetboxCircuit(),
+			"netbox_circuit_type":                 resourceNetboxCircuitType(),
+			"netbox_circuit_provider":             resourceNetboxCircuitProvider(),
+			"netbox_circuit_termination":          resourceNetboxCircuitTermination(),
+			"netbox_user":                         resourceNetboxUser(),
+			"netbox_group":                        resourceNetboxGroup(),
+			"netbox_permission":                   resourceNetboxPermission(),
+			"netbox_token":                        resourceNetboxToken(),
+			"netbox_custom_field":                 resourceCustomField(),
+			"netbox_asn":                          resourceNetboxAsn(),
+			"netbox_asn_range":                    resourceNetboxAsnRange(),
+			"netbox_available_asn":                resourceNetboxAvailableAsn(),
+			"netbox_location":                     resourceNetboxLocation(),
+			"netbox_site_group":                   resourceNetboxSiteGroup(),
+			"netbox_rack":                         resourceNetboxRack(),
+			"netbox_rack_role":                    resourceNetboxRackRole(),
+			"netbox_rack_reservation":             resourceNetboxRackReservation(),
+			"netbox_cable":                        resourceNetboxCable(),
+			"netbox_device_console_port":          resourceNetboxDeviceConsolePort(),
+			"netbox_device_console_server_port":   resourceNetboxDeviceConsoleServerPort(),
+			"netbox_device_power_port":            resourceNetboxDevicePowerPort(),
+			"netbox_device_power_outlet":          resourceNetboxDevicePowerOutlet(),
+			"netbox_device_front_port":            resourceNetboxDeviceFrontPort(),
+			"netbox_device_rear_port":             resourceNetboxDeviceRearPort(),
+			"netbox_device_module_bay":            resourceNetboxDeviceModuleBay(),
+			"netbox_device_bay":                   resourceNetboxDeviceBay(),
+			"netbox_device_bay_installation":      resourceNetboxDeviceBayInstallation(),
+			"netbox_module":                       resourceNetboxModule(),
+			"netbox_module_type":                  resourceNetboxModuleType(),
+			"netbox_power_feed":                   resourceNetboxPowerFeed(),
+			"netbox_power_panel":                  resourceNetboxPowerPanel(),
+			"netbox_inventory_item_role":          resourceNetboxInventoryItemRole(),
+			"netbox_inventory_item":               resourceNetboxInventoryItem(),
+			"netbox_webhook":                      resourceNetboxWebhook(),
+			"netbox_custom_field_choice_set":      resourceNetboxCustomFieldChoiceSet(),
+			"netbox_virtual_chassis":              resourceNetboxVirtualChassis(),
+			"netbox_virtual_disk":                 resourceNetboxVirtualDisks(),
+			"netbox_config_template":              resourceNetboxConfigTemplate(),
+			"netbox_event_rule":                   resourceNetboxEventRule(),
+			"netbox_vpn_tunnel_group":             resourceNetboxVpnTunnelGroup(),
+			"netbox_vpn_tunnel":                   resourceNetboxVpnTunnel(),
+			"netbox_vpn_tunnel_termination":       resourceNetboxVpnTunnelTermination(),
+			"netbox_l2vpn":                        resourceNetboxL2vpn(),
+			"netbox_l2vpn_termination":            resourceNetboxL2vpnTermination(),
+			"netbox_ike_proposal":                 resourceNetboxIkeProposal(),
+			"netbox_ike_policy":                   resourceNetboxIkePolicy(),
+			"netbox_ipsec_proposal":               resourceNetboxIpsecProposal(),
+			"netbox_ipsec_policy":                 resourceNetboxIpsecPolicy(),
+			"netbox_ipsec_profile":                resourceNetboxIpsecProfile(),
+			"netbox_config_context":               resourceNetboxConfigContext(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"netbox_asn":               dataSourceNetboxAsn(),
-			"netbox_asns":              dataSourceNetboxAsns(),
-			"netbox_available_prefix":  dataSourceNetboxAvailablePrefix(),
-			"netbox_cluster":           dataSourceNetboxCluster(),
-			"netbox_cluster_group":     dataSourceNetboxClusterGroup(),
-			"netbox_cluster_type":      dataSourceNetboxClusterType(),
-			"netbox_contact":           dataSourceNetboxContact(),
-			"netbox_contact_role":      dataSourceNetboxContactRole(),
-			"netbox_contact_group":     dataSourceNetboxContactGroup(),
-			"netbox_tenant":            dataSourceNetboxTenant(),
-			"netbox_tenants":           dataSourceNetboxTenants(),
-			"netbox_tenant_group":      dataSourceNetboxTenantGroup(),
-			"netbox_vrf":               dataSourceNetboxVrf(),
-			"netbox_vrfs":              dataSourceNetboxVrfs(),
-			"netbox_platform":          dataSourceNetboxPlatform(),
-			"netbox_prefix":            dataSourceNetboxPrefix(),
-			"netbox_prefixes":          dataSourceNetboxPrefixes(),
-			"netbox_devices":           dataSourceNetboxDevices(),
-			"netbox_device_role":       dataSourceNetboxDeviceRole(),
-			"netbox_device_type":       dataSourceNetboxDeviceType(),
-			"netbox_site":              dataSourceNetboxSite(),
-			"netbox_location":          dataSourceNetboxLocation(),
-			"netbox_locations":         dataSourceNetboxLocations(),
-			"netbox_tag":               dataSourceNetboxTag(),
-			"netbox_tags":              dataSourceNetboxTags(),
-			"netbox_virtual_machines":  dataSourceNetboxVirtualMachine(),
-			"netbox_interfaces":        dataSourceNetboxInterfaces(),
-			"netbox_device_interfaces": dataSourceNetboxDeviceInterfaces(),
-			"netbox_ipam_role":         dataSourceNetboxIPAMRole(),
-			"netbox_route_target":      dataSourceNetboxRouteTarget(),
-			"netbox_ip_addresses":      dataSourceNetboxIPAddresses(),
-			"netbox_ip_range":          dataSourceNetboxIPRange(),
-			"netbox_region":            dataSourceNetboxRegion(),
-			"netbox_vlan":              dataSourceNetboxVlan(),
-			"netbox_vlans":             dataSourceNetboxVlans(),
-			"netbox_vlan_group":        dataSourceNetboxVlanGroup(),
-			"netbox_site_group":        dataSourceNetboxSiteGroup(),
-			"netbox_racks":             dataSourceNetboxRacks(),
-			"netbox_rack_role":         dataSourceNetboxRackRole(),
-			"netbox_config_context":    dataSourceNetboxConfigContext(),
+			"netbox_asn":                    dataSourceNetboxAsn(),
+			"netbox_asns":                   dataSourceNetboxAsns(),
+			"netbox_asn_range":              dataSourceNetboxAsnRange(),
+			"netbox_available_prefix":       dataSourceNetboxAvailablePrefix(),
+			"netbox_available_prefixes":     dataSourceNetboxAvailablePrefixes(),
+			"netbox_available_ip_addresses": dataSourceNetboxAvailableIPAddresses(),
+			"netbox_cable_trace":            dataSourceNetboxCableTrace(),
+			"netbox_cluster":                dataSourceNetboxCluster(),
+			"netbox_cluster_group":          dataSourceNetboxClusterGroup(),
+			"netbox_cluster_type":           dataSourceNetboxClusterType(),
+			"netbox_contact":                dataSourceNetboxContact(),
+			"netbox_contact_role":           dataSourceNetboxContactRole(),
+			"netbox_contact_group":          dataSourceNetboxContactGroup(),
+			"netbox_tenant":                 dataSourceNetboxTenant(),
+			"netbox_tenants":                dataSourceNetboxTenants(),
+			"netbox_tenant_group":           dataSourceNetboxTenantGroup(),
+			"netbox_vrf":                    dataSourceNetboxVrf(),
+			"netbox_vrfs":                   dataSourceNetboxVrfs(),
+			"netbox_platform":               dataSourceNetboxPlatform(),
+			"netbox_prefix":                 dataSourceNetboxPrefix(),
+			"netbox_prefixes":               dataSourceNetboxPrefixes(),
+			"netbox_prefix_tree":            dataSourceNetboxPrefixTree(),
+			"netbox_devices":                dataSourceNetboxDevices(),
+			"netbox_device_role":            dataSourceNetboxDeviceRole(),
+			"netbox_device_type":            dataSourceNetboxDeviceType(),
+			"netbox_device_types":           dataSourceNetboxDeviceTypes(),
+			"netbox_site":                   dataSourceNetboxSite(),
+			"netbox_location":               dataSourceNetboxLocation(),
+			"netbox_locations":              dataSourceNetboxLocations(),
+			"netbox_tag":                    dataSourceNetboxTag(),
+			"netbox_tags":                   dataSourceNetboxTags(),
+			"netbox_virtual_machines":       dataSourceNetboxVirtualMachine(),
+			"netbox_interfaces":             dataSourceNetboxInterfaces(),
+			"netbox_device_interfaces":      dataSourceNetboxDeviceInterfaces(),
+			"netbox_ipam_role":              dataSourceNetboxIPAMRole(),
+			"netbox_route_target":           dataSourceNetboxRouteTarget(),
+			"netbox_route_targets":          dataSourceNetboxRouteTargets(),
+			"netbox_service_template":       dataSourceNetboxServiceTemplate(),
+			"netbox_ip_addresses":           dataSourceNetboxIPAddresses(),
+			"netbox_ip_range":               dataSourceNetboxIPRange(),
+			"netbox_ip_ranges":              dataSourceNetboxIPRanges(),
+			"netbox_aggregate":              dataSourceNetboxAggregate(),
+			"netbox_aggregates":             dataSourceNetboxAggregates(),
+			"netbox_rirs":                   dataSourceNetboxRirs(),
+			"netbox_region":                 dataSourceNetboxRegion(),
+			"netbox_vlan":                   dataSourceNetboxVlan(),
+			"netbox_vlans":                  dataSourceNetboxVlans(),
+			"netbox_available_vlans":        dataSourceNetboxAvailableVlans(),
+			"netbox_vlan_group":             dataSourceNetboxVlanGroup(),
+			"netbox_site_group":             dataSourceNetboxSiteGroup(),
+			"netbox_racks":                  dataSourceNetboxRacks(),
+			"netbox_rack_role":              dataSourceNetboxRackRole(),
+			"netbox_config_context":         dataSourceNetboxConfigContext(),
+			"netbox_l2vpn":                  dataSourceNetboxL2vpn(),
+			"netbox_l2vpns":                 dataSourceNetboxL2vpns(),
+			"netbox_ike_proposal":           dataSourceNetboxIkeProposal(),
+			"netbox_ike_policy":             dataSourceNetboxIkePolicy(),
+			"netbox_ipsec_proposal":         dataSourceNetboxIpsecProposal(),
+			"netbox_ipsec_policy":           dataSourceNetboxIpsecPolicy(),
+			"netbox_ipsec_profile":          dataSourceNetboxIpsecProfile(),
 		},
 		Schema: map[string]*schema.Schema{
 			"server_url": {
@@ -234,6 +279,24 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("NETBOX_REQUEST_TIMEOUT", 10),
 				Description: "Netbox API HTTP request timeout in seconds. Can be set via the `NETBOX_REQUEST_TIMEOUT` environment variable.",
 			},
+			"ignored_custom_fields": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of custom fields that are ignored by all resources. They are neither read into nor written from the `custom_fields` attribute. Useful for custom fields that are maintained by other systems, e.g. discovery jobs.",
+			},
+			"auto_create_tags": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_AUTO_CREATE_TAGS", false),
+				Description: "If true, tags that are referenced in `tags` but do not exist in Netbox are created (with a slug derived from the name). Can be set via the `NETBOX_AUTO_CREATE_TAGS` environment variable. Defaults to `false`.",
+			},
+			"strict_tags": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_STRICT_TAGS", false),
+				Description: "If true, a tag in `tags` that does not map to a unique tag in Netbox is an error instead of a warning. Can be set via the `NETBOX_STRICT_TAGS` environment variable. Defaults to `false`.",
+			},
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -249,6 +312,9 @@ func providerConfigure(ctx context.Context, data *schema.ResourceData) (interfac
 		Headers:                     data.Get("headers").(map[string]interface{}),
 		RequestTimeout:              data.Get("request_timeout").(int),
 		StripTrailingSlashesFromURL: data.Get("strip_trailing_slashes_from_url").(bool),
+		IgnoredCustomFields:         toStringList(data.Get("ignored_custom_fields")),
+		AutoCreateTags:              data.Get("auto_create_tags").(bool),
+		StrictTags:                  data.Get("strict_tags").(bool),
 	}
 
 	serverURL := data.Get("server_url").(string)
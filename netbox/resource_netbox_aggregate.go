@@ -1,11 +1,16 @@
 package netbox
 
 import (
+	"fmt"
+	"net/http"
+	"regexp"
 	"strconv"
+	"time"
 
 	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -23,9 +28,10 @@ func resourceNetboxAggregate() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"prefix": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.IsCIDR,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validatePrefix,
+				DiffSuppressFunc: suppressEquivalentCIDRDiff,
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -39,13 +45,39 @@ func resourceNetboxAggregate() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
+			"date_added": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "Must be a date in YYYY-MM-DD format"),
+				Description:  "The date the aggregate was allocated, in `YYYY-MM-DD` format.",
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsModeKey: customFieldsModeSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 	}
 }
+
+func getAggregateDateAdded(d *schema.ResourceData) (*strfmt.Date, error) {
+	dateAdded, ok := d.GetOk("date_added")
+	if !ok {
+		return nil, nil
+	}
+	t, err := time.Parse(strfmt.RFC3339FullDate, dateAdded.(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid date_added: %w", err)
+	}
+	date := strfmt.Date(t)
+	return &date, nil
+}
+
 func resourceNetboxAggregateCreate(d *schema.ResourceData, m interface{}) error {
 	api := m.(*client.NetBoxAPI)
 	data := models.WritableAggregate{}
@@ -64,7 +96,22 @@ func resourceNetboxAggregateCreate(d *schema.ResourceData, m interface{}) error
 		data.Rir = int64ToPtr(int64(rirID.(int)))
 	}
 
-	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	dateAdded, err := getAggregateDateAdded(d)
+	if err != nil {
+		return err
+	}
+	data.DateAdded = dateAdded
+	data.Comments = d.Get("comments").(string)
+
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
+	data.Tags = tags
+
+	if cf := getCustomFieldsForAPI(api, d); cf != nil {
+		data.CustomFields = cf
+	}
 
 	params := ipam.NewIpamAggregatesCreateParams().WithData(&data)
 	res, err := api.Ipam.IpamAggregatesCreate(params, nil)
@@ -111,7 +158,20 @@ func resourceNetboxAggregateRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("rir_id", nil)
 	}
 
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	if res.GetPayload().DateAdded != nil {
+		d.Set("date_added", res.GetPayload().DateAdded.String())
+	} else {
+		d.Set("date_added", nil)
+	}
+
+	d.Set("comments", res.GetPayload().Comments)
+
+	cf := getCustomFieldsForState(api, d, res.GetPayload().CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	d.Set(tagsKey, getTagListForState(d, res.GetPayload().Tags))
 
 	return nil
 }
@@ -134,13 +194,43 @@ func resourceNetboxAggregateUpdate(d *schema.ResourceData, m interface{}) error
 		data.Rir = int64ToPtr(int64(rirID.(int)))
 	}
 
-	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	dateAdded, err := getAggregateDateAdded(d)
+	if err != nil {
+		return err
+	}
+	data.DateAdded = dateAdded
+	data.Comments = d.Get("comments").(string)
+
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
+	data.Tags = tags
+
+	if cf := getCustomFieldsForAPI(api, d); cf != nil {
+		data.CustomFields = cf
+	}
 
 	params := ipam.NewIpamAggregatesUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Ipam.IpamAggregatesUpdate(params, nil)
+	_, err = api.Ipam.IpamAggregatesUpdate(params, nil)
 	if err != nil {
 		return err
 	}
+
+	// Omitted attributes are left untouched by the API, so they have to be cleared explicitly
+	body := map[string]interface{}{}
+	if d.HasChange("date_added") && data.DateAdded == nil {
+		body["date_added"] = nil
+	}
+	if d.HasChange("comments") && data.Comments == "" {
+		body["comments"] = ""
+	}
+	if len(body) > 0 {
+		err = netboxRequest(api, http.MethodPatch, fmt.Sprintf("/ipam/aggregates/%d/", id), nil, body, nil)
+		if err != nil {
+			return err
+		}
+	}
 	return resourceNetboxAggregateRead(d, m)
 }
 
@@ -46,6 +46,55 @@ resource "netbox_aggregate" "test" {
 	})
 }
 
+func TestAccNetboxAggregate_dateAdded(t *testing.T) {
+	testPrefix := "198.18.0.0/24"
+	testSlug := "agg_date"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_rir" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_aggregate" "test" {
+  prefix     = "%[2]s"
+  rir_id     = netbox_rir.test.id
+  date_added = "2021-03-01"
+  comments   = "%[1]s_comments"
+}`, testName, testPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_aggregate.test", "date_added", "2021-03-01"),
+					resource.TestCheckResourceAttr("netbox_aggregate.test", "comments", testName+"_comments"),
+				),
+			},
+			{
+				ResourceName:      "netbox_aggregate.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_rir" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_aggregate" "test" {
+  prefix = "%[2]s"
+  rir_id = netbox_rir.test.id
+}`, testName, testPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_aggregate.test", "date_added", ""),
+					resource.TestCheckResourceAttr("netbox_aggregate.test", "comments", ""),
+				),
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_aggregate", &resource.Sweeper{
 		Name:         "netbox_aggregate",
@@ -49,7 +49,11 @@ func resourceNetboxAsnCreate(d *schema.ResourceData, m interface{}) error {
 	rir := int64(d.Get("rir_id").(int))
 	data.Rir = &rir
 
-	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
+	data.Tags = tags
 
 	params := ipam.NewIpamAsnsCreateParams().WithData(&data)
 
@@ -86,7 +90,7 @@ func resourceNetboxAsnRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("asn", asn.Asn)
 	d.Set("rir_id", asn.Rir.ID)
 
-	d.Set(tagsKey, getTagListFromNestedTagList(asn.Tags))
+	d.Set(tagsKey, getTagListForState(d, asn.Tags))
 
 	return nil
 }
@@ -103,11 +107,15 @@ func resourceNetboxAsnUpdate(d *schema.ResourceData, m interface{}) error {
 	rir := int64(d.Get("rir_id").(int))
 	data.Rir = &rir
 
-	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
+	data.Tags = tags
 
 	params := ipam.NewIpamAsnsUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Ipam.IpamAsnsUpdate(params, nil)
+	_, err = api.Ipam.IpamAsnsUpdate(params, nil)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,199 @@
+package netbox
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// asnRange is an ASN range as returned by the Netbox API. The go-netbox client does not support ASN ranges.
+type asnRange struct {
+	ID          int64                `json:"id"`
+	Name        string               `json:"name"`
+	Slug        string               `json:"slug"`
+	Rir         *models.NestedRIR    `json:"rir"`
+	Start       int64                `json:"start"`
+	End         int64                `json:"end"`
+	Tenant      *models.NestedTenant `json:"tenant"`
+	Description string               `json:"description"`
+	Tags        []*models.NestedTag  `json:"tags"`
+}
+
+func resourceNetboxAsnRange() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxAsnRangeCreate,
+		Read:   resourceNetboxAsnRangeRead,
+		Update: resourceNetboxAsnRangeUpdate,
+		Delete: resourceNetboxAsnRangeDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/models/ipam/asnrange/):
+
+> Ranges can be defined to group AS numbers numerically and to facilitate their automatic provisioning. Each range must be assigned to a RIR.
+
+Builds of the provider for 32-bit platforms cannot hold AS numbers above 2147483647, which includes the 32-bit private range starting at 4200000000.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"rir_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"start": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateASN,
+			},
+			"end": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateASN,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			tagsKey: tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getAsnRangeRequestBody(api *client.NetBoxAPI, d *schema.ResourceData) (map[string]interface{}, error) {
+	slug := d.Get("slug").(string)
+	if slug == "" {
+		slug = getSlug(d.Get("name").(string))
+	}
+
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"slug":        slug,
+		"rir":         d.Get("rir_id").(int),
+		"start":       d.Get("start").(int),
+		"end":         d.Get("end").(int),
+		"tenant":      getOptionalInt(d, "tenant_id"),
+		"description": d.Get("description").(string),
+		"tags":        tags,
+	}, nil
+}
+
+func resourceNetboxAsnRangeCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	body, err := getAsnRangeRequestBody(api, d)
+	if err != nil {
+		return err
+	}
+
+	var res asnRange
+	err = netboxRequest(api, http.MethodPost, "/ipam/asn-ranges/", nil, body, &res)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.ID, 10))
+
+	return resourceNetboxAsnRangeRead(d, m)
+}
+
+func resourceNetboxAsnRangeRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	var res asnRange
+	err := netboxRequest(api, http.MethodGet, fmt.Sprintf("/ipam/asn-ranges/%s/", d.Id()), nil, nil, &res)
+	if err != nil {
+		if isNetboxNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", res.Name)
+	d.Set("slug", res.Slug)
+	if res.Rir != nil {
+		d.Set("rir_id", res.Rir.ID)
+	}
+	d.Set("start", res.Start)
+	d.Set("end", res.End)
+	if res.Tenant != nil {
+		d.Set("tenant_id", res.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+	d.Set("description", res.Description)
+	d.Set(tagsKey, getTagListForState(d, res.Tags))
+
+	return nil
+}
+
+func resourceNetboxAsnRangeUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	body, err := getAsnRangeRequestBody(api, d)
+	if err != nil {
+		return err
+	}
+
+	err = netboxRequest(api, http.MethodPatch, fmt.Sprintf("/ipam/asn-ranges/%s/", d.Id()), nil, body, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxAsnRangeRead(d, m)
+}
+
+func resourceNetboxAsnRangeDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	err := netboxRequest(api, http.MethodDelete, fmt.Sprintf("/ipam/asn-ranges/%s/", d.Id()), nil, nil, nil)
+	if err != nil {
+		if isNetboxNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// validateASN is a ValidateFunc for 32-bit AS numbers. The range is checked as int64, as the upper bound overflows
+// int on 32-bit platforms.
+func validateASN(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(int)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be integer", k)}
+	}
+
+	if int64(v) < 1 || int64(v) > math.MaxUint32 {
+		return nil, []error{fmt.Errorf("expected %s to be in the range (1 - %d), got %d", k, int64(math.MaxUint32), v)}
+	}
+	return nil, nil
+}
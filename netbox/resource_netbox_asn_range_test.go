@@ -0,0 +1,125 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestValidateASN(t *testing.T) {
+	// Converted at runtime, so that the test compiles on 32-bit platforms
+	maxASN := int64(math.MaxUint32)
+
+	for _, tt := range []struct {
+		name   string
+		value  int
+		valid  bool
+		only64 bool
+	}{
+		{name: "Zero", value: 0},
+		{name: "Min", value: 1, valid: true},
+		{name: "Private", value: 64512, valid: true},
+		{name: "Max", value: int(maxASN), valid: true, only64: true},
+		{name: "TooLarge", value: int(maxASN + 1), only64: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.only64 && strconv.IntSize < 64 {
+				t.Skip("value does not fit into int")
+			}
+			_, errs := validateASN(tt.value, "start")
+			if (len(errs) == 0) != tt.valid {
+				t.Fatalf("got %v for %d, expected valid: %t", errs, tt.value, tt.valid)
+			}
+		})
+	}
+}
+
+func TestAccNetboxAsnRange_basic(t *testing.T) {
+	testSlug := "asn_range_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_tenant" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_rir" "test" {
+  name       = "%[1]s"
+  is_private = true
+}
+
+resource "netbox_asn_range" "test" {
+  name        = "%[1]s"
+  rir_id      = netbox_rir.test.id
+  start       = 4200001000
+  end         = 4200001009
+  tenant_id   = netbox_tenant.test.id
+  description = "%[1]s_description"
+  tags        = [netbox_tag.test.name]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "slug", getSlug(testName)),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "start", "4200001000"),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "end", "4200001009"),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "description", testName+"_description"),
+					resource.TestCheckResourceAttr("netbox_asn_range.test", "tags.#", "1"),
+					resource.TestCheckResourceAttrPair("netbox_asn_range.test", "rir_id", "netbox_rir.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_asn_range.test", "tenant_id", "netbox_tenant.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_asn_range.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_asn_range", &resource.Sweeper{
+		Name:         "netbox_asn_range",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			var res struct {
+				Results []*asnRange `json:"results"`
+			}
+			err = netboxRequest(api, http.MethodGet, "/ipam/asn-ranges/", nil, nil, &res)
+			if err != nil {
+				return err
+			}
+			for _, asnRange := range res.Results {
+				if !strings.HasPrefix(asnRange.Name, testPrefix) {
+					continue
+				}
+				err := netboxRequest(api, http.MethodDelete, fmt.Sprintf("/ipam/asn-ranges/%d/", asnRange.ID), nil, nil, nil)
+				if err != nil {
+					return err
+				}
+				log.Print("[DEBUG] Deleted an asn range")
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,187 @@
+package netbox
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxAvailableAsn() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxAvailableAsnCreate,
+		Read:   resourceNetboxAvailableAsnRead,
+		Update: resourceNetboxAvailableAsnUpdate,
+		Delete: resourceNetboxAvailableAsnDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):Per [the docs](https://docs.netbox.dev/en/stable/models/ipam/asnrange/):
+
+> Ranges can be defined to group AS numbers numerically and to facilitate their automatic provisioning.
+
+This resource will retrieve the next available ASN from a given ASN range (specified by ID) and create it. The RIR of the ASN is inherited from the range.
+
+Builds of the provider for 32-bit platforms cannot hold AS numbers above 2147483647, which includes the 32-bit private range starting at 4200000000.`,
+
+		Schema: map[string]*schema.Schema{
+			"asn_range_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"asn": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"rir_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			tagsKey: tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getAvailableAsnRequestBody(api *client.NetBoxAPI, d *schema.ResourceData) (map[string]interface{}, error) {
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"tenant":      getOptionalInt(d, "tenant_id"),
+		"description": d.Get("description").(string),
+		"tags":        tags,
+	}, nil
+}
+
+func resourceNetboxAvailableAsnCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	rangeID := d.Get("asn_range_id").(int)
+
+	body, err := getAvailableAsnRequestBody(api, d)
+	if err != nil {
+		return err
+	}
+
+	// Netbox returns a list if a list was requested, which saves us from guessing the response format
+	var res []*models.ASN
+	err = netboxRequest(api, http.MethodPost, fmt.Sprintf("/ipam/asn-ranges/%d/available-asns/", rangeID), nil, []interface{}{body}, &res)
+	if err != nil {
+		if isNetboxConflict(err) {
+			return fmt.Errorf("no available asn left in asn range %d: %w", rangeID, err)
+		}
+		return err
+	}
+	if len(res) != 1 {
+		return fmt.Errorf("expected one allocated asn from asn range %d, got %d", rangeID, len(res))
+	}
+
+	d.SetId(strconv.FormatInt(res[0].ID, 10))
+
+	return resourceNetboxAvailableAsnRead(d, m)
+}
+
+func resourceNetboxAvailableAsnRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamAsnsReadParams().WithID(id)
+
+	res, err := api.Ipam.IpamAsnsRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*ipam.IpamAsnsReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	asn := res.GetPayload()
+	d.Set("asn", asn.Asn)
+	if asn.Rir != nil {
+		d.Set("rir_id", asn.Rir.ID)
+	}
+	if asn.Tenant != nil {
+		d.Set("tenant_id", asn.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+	d.Set("description", asn.Description)
+	d.Set(tagsKey, getTagListForState(d, asn.Tags))
+
+	// The range is not stored on the ASN, so look it up after an import
+	if _, ok := d.GetOk("asn_range_id"); !ok && asn.Asn != nil && asn.Rir != nil {
+		rangeID, err := findAsnRangeIDForAsn(api, *asn.Asn, asn.Rir.ID)
+		if err != nil {
+			return err
+		}
+		d.Set("asn_range_id", rangeID)
+	}
+
+	return nil
+}
+
+// findAsnRangeIDForAsn returns the ID of the ASN range of the given RIR that contains asn.
+func findAsnRangeIDForAsn(api *client.NetBoxAPI, asn int64, rirID int64) (int64, error) {
+	query := url.Values{
+		"rir_id":     []string{strconv.FormatInt(rirID, 10)},
+		"start__lte": []string{strconv.FormatInt(asn, 10)},
+		"end__gte":   []string{strconv.FormatInt(asn, 10)},
+		"limit":      []string{"2"},
+	}
+
+	var res struct {
+		Count   int64       `json:"count"`
+		Results []*asnRange `json:"results"`
+	}
+	err := netboxRequest(api, http.MethodGet, "/ipam/asn-ranges/", query, nil, &res)
+	if err != nil {
+		return 0, err
+	}
+	if res.Count != 1 {
+		return 0, fmt.Errorf("expected exactly one asn range containing asn %d, found %d", asn, res.Count)
+	}
+	return res.Results[0].ID, nil
+}
+
+func resourceNetboxAvailableAsnUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	body, err := getAvailableAsnRequestBody(api, d)
+	if err != nil {
+		return err
+	}
+
+	// WritableASN omits an empty tenant, so it could never be removed with the client
+	err = netboxRequest(api, http.MethodPatch, fmt.Sprintf("/ipam/asns/%s/", d.Id()), nil, body, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxAvailableAsnRead(d, m)
+}
+
+func resourceNetboxAvailableAsnDelete(d *schema.ResourceData, m interface{}) error {
+	return resourceNetboxAsnDelete(d, m)
+}
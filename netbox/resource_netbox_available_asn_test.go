@@ -0,0 +1,66 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxAvailableAsn_basic(t *testing.T) {
+	testSlug := "avail_asn"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_tenant" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_rir" "test" {
+  name       = "%[1]s"
+  is_private = true
+}
+
+resource "netbox_asn_range" "test" {
+  name   = "%[1]s"
+  rir_id = netbox_rir.test.id
+  start  = 4200002000
+  end    = 4200002009
+}
+
+resource "netbox_asn" "taken" {
+  asn    = 4200002000
+  rir_id = netbox_rir.test.id
+}
+
+resource "netbox_available_asn" "test" {
+  asn_range_id = netbox_asn_range.test.id
+  tenant_id    = netbox_tenant.test.id
+  description  = "%[1]s_description"
+  tags         = [netbox_tag.test.name]
+  depends_on   = [netbox_asn.taken]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_available_asn.test", "asn", "4200002001"),
+					resource.TestCheckResourceAttr("netbox_available_asn.test", "description", testName+"_description"),
+					resource.TestCheckResourceAttr("netbox_available_asn.test", "tags.#", "1"),
+					resource.TestCheckResourceAttrPair("netbox_available_asn.test", "rir_id", "netbox_rir.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_available_asn.test", "tenant_id", "netbox_tenant.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_available_asn.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
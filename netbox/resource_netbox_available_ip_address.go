@@ -1,11 +1,19 @@
 package netbox
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -29,22 +37,100 @@ func resourceNetboxAvailableIPAddress() *schema.Resource {
 > * DHCP
 > * SLAAC (IPv6 Stateless Address Autoconfiguration)
 
-This resource will retrieve the next available IP address from a given prefix or IP range (specified by ID)`,
+This resource will retrieve the next available IP address from a given prefix or IP range (specified by ID).
+
+Instead of a single parent, a list of candidate prefixes and IP ranges or a ` + "`prefix_selector`" + ` can be given. The candidates are tried in order until one of them has enough available IP addresses. The parent the addresses were allocated from is recorded in ` + "`parent_prefix_id`" + ` or ` + "`parent_ip_range_id`" + `.
+
+With ` + "`address_count`" + `, multiple IP addresses are reserved at once. All of them share the attributes of this resource. With ` + "`contiguous`" + `, the addresses are consecutive. Contiguous blocks are created in a single request, which relies on Netbox enforcing unique IP addresses in the prefix' VRF (or globally) to detect concurrent allocations.`,
 
 		Schema: map[string]*schema.Schema{
 			"prefix_id": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ExactlyOneOf: []string{"prefix_id", "ip_range_id"},
+				Type:          schema.TypeInt,
+				Optional:      true,
+				AtLeastOneOf:  availableIPAddressParentKeys,
+				ConflictsWith: []string{"ip_range_id", "prefix_ids", "ip_range_ids", prefixSelectorKey},
 			},
 			"ip_range_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				AtLeastOneOf:  availableIPAddressParentKeys,
+				ConflictsWith: []string{"prefix_id", "prefix_ids", "ip_range_ids", prefixSelectorKey},
+			},
+			"prefix_ids": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeInt},
+				AtLeastOneOf:  availableIPAddressParentKeys,
+				ConflictsWith: []string{"prefix_id", "ip_range_id", prefixSelectorKey},
+				Description:   "Candidate prefixes, tried in order. If `ip_range_ids` is given as well, the prefixes are tried first.",
+			},
+			"ip_range_ids": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeInt},
+				AtLeastOneOf:  availableIPAddressParentKeys,
+				ConflictsWith: []string{"prefix_id", "ip_range_id", prefixSelectorKey},
+				Description:   "Candidate IP ranges, tried in order.",
+			},
+			prefixSelectorKey: {
+				Type:          prefixSelectorSchema.Type,
+				Optional:      true,
+				MaxItems:      prefixSelectorSchema.MaxItems,
+				Elem:          prefixSelectorSchema.Elem,
+				Description:   prefixSelectorSchema.Description,
+				AtLeastOneOf:  availableIPAddressParentKeys,
+				ConflictsWith: []string{"prefix_id", "ip_range_id", "prefix_ids", "ip_range_ids"},
+			},
+			"idempotency_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "Every allocation is marked with a token in the description of the IP addresses until it is recorded in the state. " +
+					"If an idempotency key is given, it is used as token and IP addresses left behind by a failed attempt (e.g. after a timeout) are adopted instead of allocating new ones. " +
+					"Without a key, a random token is used and such IP addresses are never adopted. They keep a description starting with `terraform-allocation:` and have to be removed manually. " +
+					"The key has to be unique across all resources allocating from the same Netbox.",
+			},
+			"parent_prefix_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The prefix the IP addresses were allocated from.",
+			},
+			"parent_ip_range_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The IP range the IP addresses were allocated from.",
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The first allocated IP address.",
+			},
+			"address_count": {
 				Type:         schema.TypeInt,
 				Optional:     true,
-				ExactlyOneOf: []string{"prefix_id", "ip_range_id"},
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of IP addresses to allocate.",
 			},
-			"ip_address": {
-				Type:     schema.TypeString,
-				Computed: true,
+			"contiguous": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "If true, the allocated IP addresses are consecutive.",
+			},
+			"ip_addresses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "All allocated IP addresses, in ascending order.",
+			},
+			"ip_address_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of all allocated IP addresses, in the same order as `ip_addresses`.",
 			},
 			"interface_id": {
 				Type:         schema.TypeInt,
@@ -105,34 +191,349 @@ This resource will retrieve the next available IP address from a given prefix or
 	}
 }
 
+// availableIPAddressesMaxContiguousAttempts is the number of times a contiguous allocation is retried
+// if a concurrent allocation took some of the addresses in the meantime
+const availableIPAddressesMaxContiguousAttempts = 3
+
+// availableIPAddressParentKeys are the attributes to choose the parents to allocate IP addresses from
+var availableIPAddressParentKeys = []string{"prefix_id", "ip_range_id", "prefix_ids", "ip_range_ids", prefixSelectorKey}
+
+// errAvailableIPAddressesExhausted is returned if a parent does not have enough available IP addresses
+var errAvailableIPAddressesExhausted = errors.New("not enough available IP addresses")
+
+// availableIPAddressParent is either a prefix or an IP range to allocate IP addresses from
+type availableIPAddressParent struct {
+	PrefixID int64
+	RangeID  int64
+}
+
+// getAvailableIPAddressCandidates returns the parents to allocate IP addresses from, in the order they should be tried
+func getAvailableIPAddressCandidates(api *client.NetBoxAPI, d *schema.ResourceData) ([]availableIPAddressParent, error) {
+	var candidates []availableIPAddressParent
+	if prefixID, ok := d.GetOk("prefix_id"); ok {
+		candidates = append(candidates, availableIPAddressParent{PrefixID: int64(prefixID.(int))})
+	}
+	if rangeID, ok := d.GetOk("ip_range_id"); ok {
+		candidates = append(candidates, availableIPAddressParent{RangeID: int64(rangeID.(int))})
+	}
+	for _, prefixID := range toInt64List(d.Get("prefix_ids")) {
+		candidates = append(candidates, availableIPAddressParent{PrefixID: prefixID})
+	}
+	for _, rangeID := range toInt64List(d.Get("ip_range_ids")) {
+		candidates = append(candidates, availableIPAddressParent{RangeID: rangeID})
+	}
+	if selectors := d.Get(prefixSelectorKey).([]interface{}); len(selectors) > 0 && selectors[0] != nil {
+		prefixIDs, err := getPrefixIDsBySelector(api, selectors[0].(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		for _, prefixID := range prefixIDs {
+			candidates = append(candidates, availableIPAddressParent{PrefixID: prefixID})
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, errors.New("no prefix or IP range to allocate IP addresses from")
+	}
+	return candidates, nil
+}
+
+// availableIPAddressMarkerPrefix starts the description of IP addresses that are being allocated
+const availableIPAddressMarkerPrefix = "terraform-allocation:"
+
+// allocatedIPAddress is an IP address created by an allocation
+type allocatedIPAddress struct {
+	ID          int64  `json:"id"`
+	Address     string `json:"address"`
+	Description string `json:"description"`
+}
+
 func resourceNetboxAvailableIPAddressCreate(d *schema.ResourceData, m interface{}) error {
 	api := m.(*client.NetBoxAPI)
-	prefixID := int64(d.Get("prefix_id").(int))
-	vrfID := int64(int64(d.Get("vrf_id").(int)))
-	rangeID := int64(d.Get("ip_range_id").(int))
-	nestedvrf := models.NestedVRF{
-		ID: vrfID,
-	}
-	data := models.AvailableIP{
-		Vrf: &nestedvrf,
-	}
-	if prefixID != 0 {
-		params := ipam.NewIpamPrefixesAvailableIpsCreateParams().WithID(prefixID).WithData([]*models.AvailableIP{&data})
-		res, _ := api.Ipam.IpamPrefixesAvailableIpsCreate(params, nil)
-		// Since we generated the ip_address, set that now
-		d.SetId(strconv.FormatInt(res.Payload[0].ID, 10))
-		d.Set("ip_address", *res.Payload[0].Address)
-	}
-	if rangeID != 0 {
-		params := ipam.NewIpamIPRangesAvailableIpsCreateParams().WithID(rangeID).WithData([]*models.AvailableIP{&data})
-		res, _ := api.Ipam.IpamIPRangesAvailableIpsCreate(params, nil)
-		// Since we generated the ip_address, set that now
-		d.SetId(strconv.FormatInt(res.Payload[0].ID, 10))
-		d.Set("ip_address", *res.Payload[0].Address)
+	vrfID := int64(d.Get("vrf_id").(int))
+	count := d.Get("address_count").(int)
+	contiguous := d.Get("contiguous").(bool)
+
+	candidates, err := getAvailableIPAddressCandidates(api, d)
+	if err != nil {
+		return err
+	}
+
+	// There is nothing stable to derive a token from: the resource address is not known to the provider and
+	// resources created with count usually share their configuration. Only a configured key allows to adopt the
+	// IP addresses of a previous attempt.
+	var allocated []allocatedIPAddress
+	var parent availableIPAddressParent
+	token := d.Get("idempotency_key").(string)
+	if token == "" {
+		token = id.PrefixedUniqueId("")
+	} else {
+		// A previous attempt may have allocated the addresses without us recording them
+		allocated, parent, err = findMarkedIPAddresses(api, token)
+		if err != nil {
+			return err
+		}
+	}
+	if allocated != nil && len(allocated) != count {
+		return fmt.Errorf("found %d IP addresses allocated with idempotency key %q, expected %d. Please remove them from Netbox and try again", len(allocated), token, count)
+	}
+
+	if allocated == nil {
+		for _, parent = range candidates {
+			marker := getAvailableIPAddressMarker(token, parent)
+			if contiguous {
+				allocated, err = allocateContiguousIPAddresses(api, parent.PrefixID, parent.RangeID, vrfID, count, marker)
+			} else {
+				allocated, err = allocateAvailableIPAddresses(api, parent.PrefixID, parent.RangeID, vrfID, count, marker)
+			}
+			// Fall over to the next candidate if this one is exhausted
+			if !errors.Is(err, errAvailableIPAddressesExhausted) {
+				break
+			}
+		}
+		if errors.Is(err, errAvailableIPAddressesExhausted) && len(candidates) > 1 {
+			return fmt.Errorf("none of the %d candidates has enough available IP addresses, last one: %w", len(candidates), err)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	d.Set("parent_prefix_id", parent.PrefixID)
+	d.Set("parent_ip_range_id", parent.RangeID)
+
+	// Since we generated the ip addresses, set them now
+	addresses := make([]string, 0, len(allocated))
+	ids := make([]int64, 0, len(allocated))
+	for _, ip := range allocated {
+		addresses = append(addresses, ip.Address)
+		ids = append(ids, ip.ID)
+	}
+	d.SetId(strconv.FormatInt(ids[0], 10))
+	d.Set("ip_address", addresses[0])
+	d.Set("ip_addresses", addresses)
+	d.Set("ip_address_ids", ids)
+
+	// Replace the marker by the configured description. The update below cannot clear the description.
+	body := make([]map[string]interface{}, 0, len(ids))
+	for _, ipID := range ids {
+		body = append(body, map[string]interface{}{
+			"id":          ipID,
+			"description": d.Get("description").(string),
+		})
+	}
+	if err := netboxRequest(api, "PATCH", "/ipam/ip-addresses/", nil, body, nil); err != nil {
+		return err
 	}
+
 	return resourceNetboxAvailableIPAddressUpdate(d, m)
 }
 
+// getAvailableIPAddressMarker returns the description IP addresses are created with until they are recorded in the
+// state. Besides the token, it contains the parent the IP addresses were allocated from.
+func getAvailableIPAddressMarker(token string, parent availableIPAddressParent) string {
+	if parent.PrefixID != 0 {
+		return fmt.Sprintf("%s%s parent=prefix/%d", availableIPAddressMarkerPrefix, token, parent.PrefixID)
+	}
+	return fmt.Sprintf("%s%s parent=ip-range/%d", availableIPAddressMarkerPrefix, token, parent.RangeID)
+}
+
+// parseAvailableIPAddressMarker returns the parent contained in the given marker
+func parseAvailableIPAddressMarker(marker string) (availableIPAddressParent, bool) {
+	var parent availableIPAddressParent
+	_, ref, found := strings.Cut(marker, " parent=")
+	if !found {
+		return parent, false
+	}
+	kind, idStr, found := strings.Cut(ref, "/")
+	if !found {
+		return parent, false
+	}
+	parentID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return parent, false
+	}
+	switch kind {
+	case "prefix":
+		parent.PrefixID = parentID
+	case "ip-range":
+		parent.RangeID = parentID
+	default:
+		return parent, false
+	}
+	return parent, true
+}
+
+// findMarkedIPAddresses returns the IP addresses allocated with the given token and the parent they were allocated from.
+// If there are none, nil is returned.
+func findMarkedIPAddresses(api *client.NetBoxAPI, token string) ([]allocatedIPAddress, availableIPAddressParent, error) {
+	var parent availableIPAddressParent
+	query := url.Values{
+		"description__isw": []string{availableIPAddressMarkerPrefix + token + " parent="},
+		"limit":            []string{"1000"},
+	}
+	var res struct {
+		Results []allocatedIPAddress `json:"results"`
+	}
+	if err := netboxRequest(api, "GET", "/ipam/ip-addresses/", query, nil, &res); err != nil {
+		return nil, parent, err
+	}
+	if len(res.Results) == 0 {
+		return nil, parent, nil
+	}
+
+	parent, ok := parseAvailableIPAddressMarker(res.Results[0].Description)
+	if !ok {
+		return nil, parent, fmt.Errorf("IP address %d has an invalid allocation marker %q", res.Results[0].ID, res.Results[0].Description)
+	}
+	sortAllocatedIPAddresses(res.Results)
+	return res.Results, parent, nil
+}
+
+// allocateAvailableIPAddresses reserves the next count available IP addresses of the given prefix or IP range.
+// Netbox allocates all of them in a single transaction. The IP addresses are created with the given description.
+func allocateAvailableIPAddresses(api *client.NetBoxAPI, prefixID, rangeID, vrfID int64, count int, description string) ([]allocatedIPAddress, error) {
+	// The available-ips model of the client does not allow to set a description, so we send the request ourselves
+	body := make([]map[string]interface{}, 0, count)
+	for i := 0; i < count; i++ {
+		ip := map[string]interface{}{
+			"description": description,
+		}
+		if vrfID != 0 {
+			ip["vrf"] = vrfID
+		}
+		body = append(body, ip)
+	}
+
+	path := fmt.Sprintf("/ipam/prefixes/%d/available-ips/", prefixID)
+	if prefixID == 0 {
+		path = fmt.Sprintf("/ipam/ip-ranges/%d/available-ips/", rangeID)
+	}
+
+	var allocated []allocatedIPAddress
+	err := netboxRequest(api, "POST", path, nil, body, &allocated)
+	if err != nil {
+		// Netbox answers with a conflict if there are not enough available IP addresses
+		if isNetboxConflict(err) {
+			return nil, fmt.Errorf("%s: %w", path, errAvailableIPAddressesExhausted)
+		}
+		return nil, err
+	}
+	if len(allocated) != count {
+		return nil, fmt.Errorf("expected %d available IP addresses, got %d", count, len(allocated))
+	}
+
+	sortAllocatedIPAddresses(allocated)
+	return allocated, nil
+}
+
+// allocateContiguousIPAddresses reserves count consecutive available IP addresses of the given prefix or IP range.
+// The available-ips endpoint cannot hand out consecutive addresses, so we look for a free block and create all of its
+// addresses in a single bulk request. If another allocation took one of them in the meantime, Netbox rejects the whole
+// request and we try again with the next free block. The IP addresses are created with the given description.
+func allocateContiguousIPAddresses(api *client.NetBoxAPI, prefixID, rangeID, vrfID int64, count int, description string) ([]allocatedIPAddress, error) {
+	path := fmt.Sprintf("/ipam/prefixes/%d/available-ips/", prefixID)
+	if prefixID == 0 {
+		path = fmt.Sprintf("/ipam/ip-ranges/%d/available-ips/", rangeID)
+	}
+
+	// Unlike the available-ips endpoint, creating IP addresses directly does not inherit the VRF of the parent
+	if vrfID == 0 {
+		var parent struct {
+			VRF *netboxNestedObject `json:"vrf"`
+		}
+		parentPath := strings.TrimSuffix(path, "available-ips/")
+		if err := netboxRequest(api, "GET", parentPath, nil, nil, &parent); err != nil {
+			return nil, err
+		}
+		if parent.VRF != nil {
+			vrfID = parent.VRF.ID
+		}
+	}
+
+	var err error
+	for attempt := 0; attempt < availableIPAddressesMaxContiguousAttempts; attempt++ {
+		var available []*models.AvailableIP
+		err = netboxRequest(api, "GET", path, url.Values{"limit": []string{"1000"}}, nil, &available)
+		if err != nil {
+			return nil, err
+		}
+
+		addresses := make([]string, 0, len(available))
+		for _, ip := range available {
+			addresses = append(addresses, ip.Address)
+		}
+		block, ok := findContiguousIPAddresses(addresses, count)
+		if !ok {
+			return nil, fmt.Errorf("no block of %d contiguous IP addresses in %s: %w", count, path, errAvailableIPAddressesExhausted)
+		}
+
+		body := make([]map[string]interface{}, 0, count)
+		for _, address := range block {
+			ip := map[string]interface{}{
+				"address":     address,
+				"description": description,
+			}
+			if vrfID != 0 {
+				ip["vrf"] = vrfID
+			}
+			body = append(body, ip)
+		}
+
+		var allocated []allocatedIPAddress
+		err = netboxRequest(api, "POST", "/ipam/ip-addresses/", nil, body, &allocated)
+		if err == nil {
+			sortAllocatedIPAddresses(allocated)
+			return allocated, nil
+		}
+		if apiErr, ok := err.(*netboxAPIError); !ok || apiErr.Code != http.StatusBadRequest {
+			return nil, err
+		}
+	}
+	return nil, fmt.Errorf("could not allocate %d contiguous IP addresses after %d attempts: %w", count, availableIPAddressesMaxContiguousAttempts, err)
+}
+
+// findContiguousIPAddresses returns the first count consecutive addresses of the given addresses (in CIDR notation),
+// which have to be sorted in ascending order.
+func findContiguousIPAddresses(addresses []string, count int) ([]string, bool) {
+	start := 0
+	var prev netip.Addr
+	for i, address := range addresses {
+		prefix, err := netip.ParsePrefix(address)
+		if err != nil {
+			return nil, false
+		}
+		if i == 0 || prev.Next() != prefix.Addr() {
+			start = i
+		}
+		prev = prefix.Addr()
+		if i-start+1 == count {
+			return addresses[start : i+1], true
+		}
+	}
+	return nil, false
+}
+
+func sortAllocatedIPAddresses(allocated []allocatedIPAddress) {
+	sort.Slice(allocated, func(i, j int) bool {
+		a, errA := netip.ParsePrefix(allocated[i].Address)
+		b, errB := netip.ParsePrefix(allocated[j].Address)
+		if errA != nil || errB != nil {
+			return allocated[i].Address < allocated[j].Address
+		}
+		return a.Addr().Less(b.Addr())
+	})
+}
+
+// getAvailableIPAddressIDs returns the IDs of all IP addresses managed by the resource. Imported resources only
+// know their own ID.
+func getAvailableIPAddressIDs(d *schema.ResourceData) []int64 {
+	ids := toInt64List(d.Get("ip_address_ids"))
+	if len(ids) == 0 {
+		id, _ := strconv.ParseInt(d.Id(), 10, 64)
+		ids = []int64{id}
+	}
+	return ids
+}
+
 func resourceNetboxAvailableIPAddressRead(d *schema.ResourceData, m interface{}) error {
 	api := m.(*client.NetBoxAPI)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
@@ -191,17 +592,39 @@ func resourceNetboxAvailableIPAddressRead(d *schema.ResourceData, m interface{})
 	d.Set("ip_address", ipAddress.Address)
 	d.Set("description", ipAddress.Description)
 	d.Set("status", ipAddress.Status.Value)
-	d.Set(tagsKey, getTagListFromNestedTagList(ipAddress.Tags))
+	d.Set(tagsKey, getTagListForState(d, ipAddress.Tags))
+
+	// The addresses are allocated as a unit, so if any of them is gone, the whole resource is gone
+	ids := getAvailableIPAddressIDs(d)
+	addresses := []string{*ipAddress.Address}
+	for _, otherID := range ids[1:] {
+		params := ipam.NewIpamIPAddressesReadParams().WithID(otherID)
+		res, err := api.Ipam.IpamIPAddressesRead(params, nil)
+		if err != nil {
+			if errresp, ok := err.(*ipam.IpamIPAddressesReadDefault); ok && errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+			return err
+		}
+		addresses = append(addresses, *res.GetPayload().Address)
+	}
+	d.Set("ip_addresses", addresses)
+	d.Set("ip_address_ids", ids)
+
+	// Imported resources have no count yet
+	if d.Get("address_count").(int) == 0 {
+		d.Set("address_count", len(ids))
+		d.Set("contiguous", false)
+	}
 	return nil
 }
 
 func resourceNetboxAvailableIPAddressUpdate(d *schema.ResourceData, m interface{}) error {
 	api := m.(*client.NetBoxAPI)
 
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	data := models.WritableIPAddress{}
 
-	data.Address = strToPtr(d.Get("ip_address").(string))
 	data.Status = d.Get("status").(string)
 
 	data.Description = getOptionalStr(d, "description", false)
@@ -237,32 +660,45 @@ func resourceNetboxAvailableIPAddressUpdate(d *schema.ResourceData, m interface{
 		data.AssignedObjectID = nil
 	}
 
-	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
-
-	params := ipam.NewIpamIPAddressesUpdateParams().WithID(id).WithData(&data)
-
-	_, err := api.Ipam.IpamIPAddressesUpdate(params, nil)
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
 	if err != nil {
 		return err
 	}
+	data.Tags = tags
+
+	ids := getAvailableIPAddressIDs(d)
+	addresses := toStringList(d.Get("ip_addresses"))
+	if len(addresses) != len(ids) {
+		addresses = []string{d.Get("ip_address").(string)}
+	}
+	for i, id := range ids {
+		data.Address = strToPtr(addresses[i])
+		params := ipam.NewIpamIPAddressesUpdateParams().WithID(id).WithData(&data)
+
+		_, err = api.Ipam.IpamIPAddressesUpdate(params, nil)
+		if err != nil {
+			return err
+		}
+	}
 	return resourceNetboxAvailableIPAddressRead(d, m)
 }
 
 func resourceNetboxAvailableIPAddressDelete(d *schema.ResourceData, m interface{}) error {
 	api := m.(*client.NetBoxAPI)
 
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := ipam.NewIpamIPAddressesDeleteParams().WithID(id)
+	for _, id := range getAvailableIPAddressIDs(d) {
+		params := ipam.NewIpamIPAddressesDeleteParams().WithID(id)
 
-	_, err := api.Ipam.IpamIPAddressesDelete(params, nil)
-	if err != nil {
-		if errresp, ok := err.(*ipam.IpamIPAddressesDeleteDefault); ok {
-			if errresp.Code() == 404 {
-				d.SetId("")
-				return nil
+		_, err := api.Ipam.IpamIPAddressesDelete(params, nil)
+		if err != nil {
+			if errresp, ok := err.(*ipam.IpamIPAddressesDeleteDefault); ok {
+				if errresp.Code() == 404 {
+					continue
+				}
 			}
+			return err
 		}
-		return err
 	}
+	d.SetId("")
 	return nil
 }
@@ -1,8 +1,13 @@
 package netbox
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"reflect"
 	"regexp"
 	"testing"
 
@@ -10,6 +15,8 @@ import (
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccNetboxAvailableIPAddress_basic(t *testing.T) {
@@ -285,6 +292,378 @@ resource "netbox_available_ip_address" "test" {
 	})
 }
 
+func TestAccNetboxAvailableIPAddress_count(t *testing.T) {
+	testPrefix := "1.1.20.0/24"
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_prefix" "test" {
+  prefix = "%s"
+  status = "active"
+  is_pool = false
+}
+resource "netbox_available_ip_address" "test" {
+  prefix_id = netbox_prefix.test.id
+  address_count = 3
+  status = "reserved"
+}`, testPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_available_ip_address.test", "ip_address", "1.1.20.1/24"),
+					resource.TestCheckResourceAttr("netbox_available_ip_address.test", "ip_addresses.#", "3"),
+					resource.TestCheckResourceAttr("netbox_available_ip_address.test", "ip_addresses.0", "1.1.20.1/24"),
+					resource.TestCheckResourceAttr("netbox_available_ip_address.test", "ip_addresses.2", "1.1.20.3/24"),
+					resource.TestCheckResourceAttr("netbox_available_ip_address.test", "ip_address_ids.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxAvailableIPAddress_contiguous(t *testing.T) {
+	testPrefix := "1.1.21.0/24"
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_prefix" "test" {
+  prefix = "%s"
+  status = "active"
+  is_pool = false
+}
+resource "netbox_ip_address" "blocker" {
+  ip_address = "1.1.21.3/24"
+  status = "active"
+  depends_on = [netbox_prefix.test]
+}
+resource "netbox_available_ip_address" "test" {
+  prefix_id = netbox_prefix.test.id
+  address_count = 4
+  contiguous = true
+  depends_on = [netbox_ip_address.blocker]
+}`, testPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_available_ip_address.test", "ip_address", "1.1.21.4/24"),
+					resource.TestCheckResourceAttr("netbox_available_ip_address.test", "ip_addresses.#", "4"),
+					resource.TestCheckResourceAttr("netbox_available_ip_address.test", "ip_addresses.0", "1.1.21.4/24"),
+					resource.TestCheckResourceAttr("netbox_available_ip_address.test", "ip_addresses.3", "1.1.21.7/24"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxAvailableIPAddress_fallover(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "netbox_prefix" "small" {
+  prefix = "1.1.22.0/30"
+  status = "active"
+}
+resource "netbox_prefix" "large" {
+  prefix = "1.1.22.16/28"
+  status = "active"
+}
+resource "netbox_available_ip_address" "test" {
+  prefix_ids = [netbox_prefix.small.id, netbox_prefix.large.id]
+  address_count = 3
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_available_ip_address.test", "ip_address", "1.1.22.17/28"),
+					resource.TestCheckResourceAttrPair("netbox_available_ip_address.test", "parent_prefix_id", "netbox_prefix.large", "id"),
+					resource.TestCheckResourceAttr("netbox_available_ip_address.test", "parent_ip_range_id", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxAvailableIPAddress_prefixSelector(t *testing.T) {
+	testSlug := "avail_ip_sel"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%s"
+}
+resource "netbox_prefix" "test" {
+  prefix = "1.1.23.0/24"
+  status = "active"
+  is_pool = true
+  tags = [netbox_tag.test.name]
+}
+resource "netbox_available_ip_address" "test" {
+  prefix_selector {
+    tag = netbox_tag.test.slug
+    is_pool = true
+  }
+  depends_on = [netbox_prefix.test]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_available_ip_address.test", "ip_address", "1.1.23.0/24"),
+					resource.TestCheckResourceAttrPair("netbox_available_ip_address.test", "parent_prefix_id", "netbox_prefix.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxAvailableIPAddress_adoptOrphan(t *testing.T) {
+	testSlug := "avail_ip_adopt"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_prefix" "test" {
+  prefix = "1.1.24.0/24"
+  status = "active"
+}
+# An allocation left behind by a previous attempt that timed out
+resource "netbox_ip_address" "orphan" {
+  ip_address = "1.1.24.5/24"
+  status = "active"
+  description = "terraform-allocation:%[1]s parent=prefix/${netbox_prefix.test.id}"
+}
+resource "netbox_available_ip_address" "test" {
+  prefix_id = netbox_prefix.test.id
+  idempotency_key = "%[1]s"
+  description = "adopted"
+  depends_on = [netbox_ip_address.orphan]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_available_ip_address.test", "ip_address", "1.1.24.5/24"),
+					resource.TestCheckResourceAttr("netbox_available_ip_address.test", "description", "adopted"),
+					resource.TestCheckResourceAttrPair("netbox_available_ip_address.test", "id", "netbox_ip_address.orphan", "id"),
+					resource.TestCheckResourceAttrPair("netbox_available_ip_address.test", "parent_prefix_id", "netbox_prefix.test", "id"),
+				),
+				// The orphan's description was replaced by the adopting resource
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAvailableIPAddressMarker(t *testing.T) {
+	for _, parent := range []availableIPAddressParent{{PrefixID: 12}, {RangeID: 3}} {
+		marker := getAvailableIPAddressMarker("abc", parent)
+		parsed, ok := parseAvailableIPAddressMarker(marker)
+		if !ok || parsed != parent {
+			t.Fatalf("marker %q parsed to %v (%t), expected %v", marker, parsed, ok, parent)
+		}
+	}
+
+	if _, ok := parseAvailableIPAddressMarker("terraform-allocation:abc"); ok {
+		t.Fatal("marker without parent must not be parsed")
+	}
+}
+
+func TestFindContiguousIPAddresses(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		addresses []string
+		count     int
+		expected  []string
+	}{
+		{
+			name:      "Start",
+			addresses: []string{"10.0.0.1/24", "10.0.0.2/24", "10.0.0.5/24"},
+			count:     2,
+			expected:  []string{"10.0.0.1/24", "10.0.0.2/24"},
+		},
+		{
+			name:      "AfterGap",
+			addresses: []string{"10.0.0.1/24", "10.0.0.2/24", "10.0.0.5/24", "10.0.0.6/24", "10.0.0.7/24"},
+			count:     3,
+			expected:  []string{"10.0.0.5/24", "10.0.0.6/24", "10.0.0.7/24"},
+		},
+		{
+			name:      "IPv6",
+			addresses: []string{"2001:db8::1/64", "2001:db8::3/64", "2001:db8::4/64"},
+			count:     2,
+			expected:  []string{"2001:db8::3/64", "2001:db8::4/64"},
+		},
+		{
+			name:      "NotFound",
+			addresses: []string{"10.0.0.1/24", "10.0.0.3/24", "10.0.0.5/24"},
+			count:     2,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, ok := findContiguousIPAddresses(tt.addresses, tt.count)
+			if ok != (tt.expected != nil) || !reflect.DeepEqual(actual, tt.expected) {
+				t.Fatalf("got %v (%t), expected %v", actual, ok, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGetAvailableIPAddressCandidates(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		raw      map[string]interface{}
+		expected []availableIPAddressParent
+	}{
+		{
+			name:     "Prefix",
+			raw:      map[string]interface{}{"prefix_id": 1},
+			expected: []availableIPAddressParent{{PrefixID: 1}},
+		},
+		{
+			name:     "IPRange",
+			raw:      map[string]interface{}{"ip_range_id": 2},
+			expected: []availableIPAddressParent{{RangeID: 2}},
+		},
+		{
+			name: "Lists",
+			raw: map[string]interface{}{
+				"prefix_ids":   []interface{}{3, 1},
+				"ip_range_ids": []interface{}{2},
+			},
+			expected: []availableIPAddressParent{{PrefixID: 3}, {PrefixID: 1}, {RangeID: 2}},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceNetboxAvailableIPAddress().Schema, tt.raw)
+			actual, err := getAvailableIPAddressCandidates(nil, d)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestAvailableIPAddressCreateIdempotencyKey(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		key        string
+		expectedID string
+		allocates  bool
+	}{
+		{name: "Adopted", key: "my-key", expectedID: "31"},
+		{name: "NoKey", expectedID: "32", allocates: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var searched, allocated bool
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/api/ipam/ip-addresses/":
+					searched = true
+					fmt.Fprintf(w, `{"count": 1, "results": [{"id": 31, "address": "10.0.0.31/24", "description": "terraform-allocation:%s parent=prefix/1"}]}`, tt.key)
+				case r.Method == http.MethodPost && r.URL.Path == "/api/ipam/prefixes/1/available-ips/":
+					allocated = true
+					w.WriteHeader(http.StatusCreated)
+					fmt.Fprint(w, `[{"id": 32, "address": "10.0.0.32/24"}]`)
+				case r.Method == http.MethodPatch && r.URL.Path == "/api/ipam/ip-addresses/":
+					fmt.Fprint(w, `[]`)
+				default:
+					id := path.Base(r.URL.Path)
+					fmt.Fprintf(w, `{"id": %s, "address": "10.0.0.%s/24", "status": {"value": "active"}, "tags": []}`, id, id)
+				}
+			}))
+			defer ts.Close()
+
+			config := Config{APIToken: "07b12b765127747e4afd56cb531b7bf9c61f3c30", ServerURL: ts.URL}
+			api, err := config.Client()
+			assert.NoError(t, err)
+
+			d := schema.TestResourceDataRaw(t, resourceNetboxAvailableIPAddress().Schema, map[string]interface{}{
+				"prefix_id":       1,
+				"address_count":   1,
+				"status":          "active",
+				"idempotency_key": tt.key,
+			})
+			assert.NoError(t, resourceNetboxAvailableIPAddressCreate(d, api))
+			assert.Equal(t, tt.expectedID, d.Id())
+			assert.Equal(t, 1, d.Get("parent_prefix_id"))
+			assert.Equal(t, tt.key != "", searched)
+			assert.Equal(t, tt.allocates, allocated)
+		})
+	}
+}
+
+func TestAvailableIPAddressMultipleAddresses(t *testing.T) {
+	var updated, deleted []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := path.Base(r.URL.Path)
+		switch r.Method {
+		case http.MethodDelete:
+			deleted = append(deleted, id)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		case http.MethodPut:
+			updated = append(updated, id)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id": %s, "address": "10.0.0.%s/24", "status": {"value": "active"}, "tags": []}`, id, id)
+	}))
+	defer ts.Close()
+
+	config := Config{APIToken: "07b12b765127747e4afd56cb531b7bf9c61f3c30", ServerURL: ts.URL}
+	api, err := config.Client()
+	assert.NoError(t, err)
+
+	d := schema.TestResourceDataRaw(t, resourceNetboxAvailableIPAddress().Schema, map[string]interface{}{
+		"prefix_id":     1,
+		"address_count": 2,
+		"status":        "active",
+	})
+	d.SetId("11")
+	d.Set("ip_address_ids", []int{11, 12})
+	d.Set("ip_addresses", []string{"10.0.0.11/24", "10.0.0.12/24"})
+
+	assert.Equal(t, []int64{11, 12}, getAvailableIPAddressIDs(d))
+
+	assert.NoError(t, resourceNetboxAvailableIPAddressUpdate(d, api))
+	assert.Equal(t, []string{"11", "12"}, updated)
+	assert.Equal(t, []interface{}{"10.0.0.11/24", "10.0.0.12/24"}, d.Get("ip_addresses"))
+
+	assert.NoError(t, resourceNetboxAvailableIPAddressDelete(d, api))
+	assert.Equal(t, []string{"11", "12"}, deleted)
+}
+
+func TestAllocateContiguousIPAddressesParentVRF(t *testing.T) {
+	var created []map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/ipam/prefixes/1/":
+			fmt.Fprint(w, `{"id": 1, "prefix": "10.0.0.0/24", "vrf": {"id": 7}}`)
+		case "/api/ipam/prefixes/1/available-ips/":
+			fmt.Fprint(w, `[{"address": "10.0.0.1/24", "family": 4}, {"address": "10.0.0.2/24", "family": 4}]`)
+		case "/api/ipam/ip-addresses/":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `[{"id": 21, "address": "10.0.0.1/24"}, {"id": 22, "address": "10.0.0.2/24"}]`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{APIToken: "07b12b765127747e4afd56cb531b7bf9c61f3c30", ServerURL: ts.URL}
+	api, err := config.Client()
+	assert.NoError(t, err)
+
+	allocated, err := allocateContiguousIPAddresses(api, 1, 0, 0, 2, "test")
+	assert.NoError(t, err)
+	assert.Len(t, allocated, 2)
+	assert.Len(t, created, 2)
+	for _, ip := range created {
+		assert.Equal(t, float64(7), ip["vrf"])
+	}
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_available_ip_address", &resource.Sweeper{
 		Name:         "netbox_available_ip_address",
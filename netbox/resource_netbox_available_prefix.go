@@ -2,13 +2,15 @@ package netbox
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/netip"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
-	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -16,17 +18,48 @@ import (
 func resourceNetboxAvailablePrefix() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNetboxAvailablePrefixCreate,
-		Read:   resourceNetboxPrefixRead,
-		Update: resourceNetboxPrefixUpdate,
-		Delete: resourceNetboxPrefixDelete,
+		Read:   resourceNetboxAvailablePrefixRead,
+		Update: resourceNetboxAvailablePrefixUpdate,
+		Delete: resourceNetboxAvailablePrefixDelete,
 
-		Description: `:meta:subcategory:IP Address Management (IPAM):`,
+		Description: `:meta:subcategory:IP Address Management (IPAM):This resource will allocate the next available prefix of the given length from a parent prefix.
+
+The parent is given by ` + "`parent_prefix_id`" + `, a list of candidates in ` + "`parent_prefix_ids`" + ` or a ` + "`prefix_selector`" + `. Candidates are tried in order until one of them has enough space. With ` + "`prefix_count`" + `, several prefixes of the same length are allocated from the same parent at once. All of them share the attributes of this resource.`,
 
 		Schema: map[string]*schema.Schema{
 			"parent_prefix_id": {
-				Type:     schema.TypeInt,
-				Required: true,
-				ForceNew: true,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				AtLeastOneOf:  availablePrefixParentKeys,
+				ConflictsWith: []string{"parent_prefix_ids", prefixSelectorKey},
+				Description:   "The prefix to allocate from. If the parent is chosen from `parent_prefix_ids` or by `prefix_selector`, this is the prefix that was allocated from.",
+			},
+			"parent_prefix_ids": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeInt},
+				AtLeastOneOf:  availablePrefixParentKeys,
+				ConflictsWith: []string{"parent_prefix_id", prefixSelectorKey},
+				Description:   "Candidate parent prefixes, tried in order.",
+			},
+			prefixSelectorKey: {
+				Type:          prefixSelectorSchema.Type,
+				Optional:      true,
+				MaxItems:      prefixSelectorSchema.MaxItems,
+				Elem:          prefixSelectorSchema.Elem,
+				Description:   prefixSelectorSchema.Description,
+				AtLeastOneOf:  availablePrefixParentKeys,
+				ConflictsWith: []string{"parent_prefix_id", "parent_prefix_ids"},
+			},
+			"prefix_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of prefixes to allocate.",
 			},
 			"prefix_length": {
 				Type:         schema.TypeInt,
@@ -35,8 +68,21 @@ func resourceNetboxAvailablePrefix() *schema.Resource {
 				ValidateFunc: validation.IntBetween(0, 128),
 			},
 			"prefix": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The first allocated prefix.",
+			},
+			"prefixes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "All allocated prefixes, in ascending order.",
+			},
+			"prefix_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of all allocated prefixes, in the same order as `prefixes`.",
 			},
 			"status": {
 				Type:         schema.TypeString,
@@ -76,7 +122,9 @@ func resourceNetboxAvailablePrefix() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsModeKey: customFieldsModeSchema,
+			tagsKey:             tagsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: func(c context.Context, rd *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -114,24 +162,188 @@ func resourceNetboxAvailablePrefixParseImport(importStr string) (int, string, in
 	return parentID, parts[1], prefixLength, nil
 }
 
+// availablePrefixParentKeys are the attributes to choose the parents to allocate prefixes from
+var availablePrefixParentKeys = []string{"parent_prefix_id", "parent_prefix_ids", prefixSelectorKey}
+
+// allocatedPrefix is a prefix created by an allocation
+type allocatedPrefix struct {
+	ID     int64  `json:"id"`
+	Prefix string `json:"prefix"`
+}
+
 func resourceNetboxAvailablePrefixCreate(d *schema.ResourceData, m interface{}) error {
 	api := m.(*client.NetBoxAPI)
 
-	parentPrefixID := int64(d.Get("parent_prefix_id").(int))
-	prefixLength := int64(d.Get("prefix_length").(int))
-	data := models.PrefixLength{
-		PrefixLength: &prefixLength,
+	candidates, err := getAvailablePrefixCandidates(api, d)
+	if err != nil {
+		return err
 	}
-	params := ipam.NewIpamPrefixesAvailablePrefixesCreateParams().WithID(parentPrefixID).WithData(&data)
 
-	res, err := api.Ipam.IpamPrefixesAvailablePrefixesCreate(params, nil)
+	prefixLength := d.Get("prefix_length").(int)
+	count := d.Get("prefix_count").(int)
+
+	var allocated []allocatedPrefix
+	var parentPrefixID int64
+	for _, parentPrefixID = range candidates {
+		allocated, err = allocateAvailablePrefixes(api, parentPrefixID, prefixLength, count)
+		// Fall over to the next candidate if this one is exhausted
+		if !isNetboxConflict(err) {
+			break
+		}
+	}
 	if err != nil {
+		if isNetboxConflict(err) && len(candidates) > 1 {
+			return fmt.Errorf("none of the %d candidates has space for %d prefixes of length %d, last one: %w", len(candidates), count, prefixLength, err)
+		}
 		return err
 	}
 
-	payload := res.GetPayload()
-	d.SetId(strconv.FormatInt(payload.ID, 10))
-	d.Set("prefix", payload.Prefix)
+	prefixes := make([]string, 0, len(allocated))
+	ids := make([]int64, 0, len(allocated))
+	for _, prefix := range allocated {
+		prefixes = append(prefixes, prefix.Prefix)
+		ids = append(ids, prefix.ID)
+	}
+	d.SetId(strconv.FormatInt(ids[0], 10))
+	d.Set("parent_prefix_id", parentPrefixID)
+	d.Set("prefix", prefixes[0])
+	d.Set("prefixes", prefixes)
+	d.Set("prefix_ids", ids)
+
+	return resourceNetboxAvailablePrefixUpdate(d, m)
+}
 
-	return resourceNetboxPrefixUpdate(d, m)
+// getAvailablePrefixCandidates returns the parents to allocate prefixes from, in the order they should be tried
+func getAvailablePrefixCandidates(api *client.NetBoxAPI, d *schema.ResourceData) ([]int64, error) {
+	var candidates []int64
+	if parentPrefixID, ok := d.GetOk("parent_prefix_id"); ok {
+		candidates = append(candidates, int64(parentPrefixID.(int)))
+	}
+	candidates = append(candidates, toInt64List(d.Get("parent_prefix_ids"))...)
+	if selectors := d.Get(prefixSelectorKey).([]interface{}); len(selectors) > 0 && selectors[0] != nil {
+		prefixIDs, err := getPrefixIDsBySelector(api, selectors[0].(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, prefixIDs...)
+	}
+	if len(candidates) == 0 {
+		return nil, errors.New("no parent prefix to allocate prefixes from")
+	}
+	return candidates, nil
+}
+
+// allocateAvailablePrefixes allocates count prefixes of the given length from the given parent.
+// Netbox allocates all of them in a single transaction and answers with a conflict if there is not enough space.
+func allocateAvailablePrefixes(api *client.NetBoxAPI, parentPrefixID int64, prefixLength int, count int) ([]allocatedPrefix, error) {
+	// The client only supports allocating a single prefix, so we send the request ourselves
+	body := make([]map[string]interface{}, 0, count)
+	for i := 0; i < count; i++ {
+		body = append(body, map[string]interface{}{
+			"prefix_length": prefixLength,
+		})
+	}
+
+	var allocated []allocatedPrefix
+	err := netboxRequest(api, "POST", fmt.Sprintf("/ipam/prefixes/%d/available-prefixes/", parentPrefixID), nil, body, &allocated)
+	if err != nil {
+		return nil, err
+	}
+	if len(allocated) != count {
+		return nil, fmt.Errorf("expected %d available prefixes, got %d", count, len(allocated))
+	}
+
+	sort.Slice(allocated, func(i, j int) bool {
+		a, errA := netip.ParsePrefix(allocated[i].Prefix)
+		b, errB := netip.ParsePrefix(allocated[j].Prefix)
+		if errA != nil || errB != nil {
+			return allocated[i].Prefix < allocated[j].Prefix
+		}
+		return a.Addr().Less(b.Addr())
+	})
+	return allocated, nil
+}
+
+// getAvailablePrefixIDs returns the IDs of all prefixes managed by the resource. Imported resources only
+// know their own ID.
+func getAvailablePrefixIDs(d *schema.ResourceData) []int64 {
+	ids := toInt64List(d.Get("prefix_ids"))
+	if len(ids) == 0 {
+		id, _ := strconv.ParseInt(d.Id(), 10, 64)
+		ids = []int64{id}
+	}
+	return ids
+}
+
+func resourceNetboxAvailablePrefixRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	ids := getAvailablePrefixIDs(d)
+	if err := resourceNetboxPrefixRead(d, m); err != nil || d.Id() == "" {
+		return err
+	}
+
+	// The prefixes are allocated as a unit, so if any of them is gone, the whole resource is gone
+	prefixes := []string{d.Get("prefix").(string)}
+	for _, id := range ids[1:] {
+		params := ipam.NewIpamPrefixesReadParams().WithID(id)
+		res, err := api.Ipam.IpamPrefixesRead(params, nil)
+		if err != nil {
+			if errresp, ok := err.(*ipam.IpamPrefixesReadDefault); ok && errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+			return err
+		}
+		prefixes = append(prefixes, *res.GetPayload().Prefix)
+	}
+	d.Set("prefixes", prefixes)
+	d.Set("prefix_ids", ids)
+
+	// Imported resources have no count yet
+	if d.Get("prefix_count").(int) == 0 {
+		d.Set("prefix_count", len(ids))
+	}
+	return nil
+}
+
+func resourceNetboxAvailablePrefixUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	data, err := getWritablePrefixFromResourceData(api, d)
+	if err != nil {
+		return err
+	}
+
+	ids := getAvailablePrefixIDs(d)
+	prefixes := toStringList(d.Get("prefixes"))
+	if len(prefixes) != len(ids) {
+		prefixes = []string{d.Get("prefix").(string)}
+	}
+	for i, id := range ids {
+		data.Prefix = &prefixes[i]
+		params := ipam.NewIpamPrefixesUpdateParams().WithID(id).WithData(data)
+		_, err = api.Ipam.IpamPrefixesUpdate(params, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return resourceNetboxAvailablePrefixRead(d, m)
+}
+
+func resourceNetboxAvailablePrefixDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	for _, id := range getAvailablePrefixIDs(d) {
+		params := ipam.NewIpamPrefixesDeleteParams().WithID(id)
+		_, err := api.Ipam.IpamPrefixesDelete(params, nil)
+		if err != nil {
+			if errresp, ok := err.(*ipam.IpamPrefixesDeleteDefault); ok && errresp.Code() == 404 {
+				continue
+			}
+			return err
+		}
+	}
+	d.SetId("")
+	return nil
 }
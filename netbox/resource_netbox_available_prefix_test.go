@@ -2,6 +2,9 @@ package netbox
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
 	"regexp"
 	"testing"
 
@@ -9,8 +12,10 @@ import (
 	"github.com/fbreckle/go-netbox/netbox/client/ipam"
 	"github.com/fbreckle/go-netbox/netbox/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
 )
 
 func testAccNetboxAvailablePrefixFullDependencies(testName string, parentPrefix string) string {
@@ -28,6 +33,52 @@ resource "netbox_prefix" "parent" {
 `, testName, parentPrefix)
 }
 
+func TestAvailablePrefixMultiplePrefixes(t *testing.T) {
+	var updated, deleted []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		id := path.Base(r.URL.Path)
+		switch {
+		case r.URL.Path == "/api/ipam/prefixes/5/available-prefixes/":
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"detail": "Insufficient space is available to accommodate the requested prefix size(s)"}`)
+		case r.URL.Path == "/api/ipam/prefixes/6/available-prefixes/":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `[{"id": 12, "prefix": "10.0.1.0/24"}, {"id": 11, "prefix": "10.0.0.0/24"}]`)
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, id)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			if r.Method == http.MethodPut {
+				updated = append(updated, id)
+			}
+			fmt.Fprintf(w, `{"id": %s, "prefix": "10.0.%d.0/24", "status": {"value": "active"}, "tags": []}`, id, map[string]int{"11": 0, "12": 1}[id])
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{APIToken: "07b12b765127747e4afd56cb531b7bf9c61f3c30", ServerURL: ts.URL}
+	api, err := config.Client()
+	assert.NoError(t, err)
+
+	d := schema.TestResourceDataRaw(t, resourceNetboxAvailablePrefix().Schema, map[string]interface{}{
+		"parent_prefix_ids": []interface{}{5, 6},
+		"prefix_length":     24,
+		"prefix_count":      2,
+		"status":            "active",
+	})
+
+	assert.NoError(t, resourceNetboxAvailablePrefixCreate(d, api))
+	assert.Equal(t, "11", d.Id())
+	assert.Equal(t, 6, d.Get("parent_prefix_id"))
+	assert.Equal(t, []int64{11, 12}, getAvailablePrefixIDs(d))
+	assert.Equal(t, []interface{}{"10.0.0.0/24", "10.0.1.0/24"}, d.Get("prefixes"))
+	assert.Equal(t, []string{"11", "12"}, updated)
+
+	assert.NoError(t, resourceNetboxAvailablePrefixDelete(d, api))
+	assert.Equal(t, []string{"11", "12"}, deleted)
+}
+
 func TestAccNetboxAvailablePrefix_basic(t *testing.T) {
 	testParentPrefix := "1.1.0.0/24"
 	testPrefixLength := 25
@@ -166,6 +217,79 @@ resource "netbox_available_prefix" "test3" {
 	})
 }
 
+func TestAccNetboxAvailablePrefix_prefixCount(t *testing.T) {
+	testSlug := "avail_prefix_count"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxAvailablePrefixFullDependencies(testName, "1.1.30.0/24") + `
+resource "netbox_available_prefix" "test" {
+  parent_prefix_id = netbox_prefix.parent.id
+  prefix_length = 26
+  prefix_count = 3
+  status = "reserved"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_available_prefix.test", "prefix", "1.1.30.0/26"),
+					resource.TestCheckResourceAttr("netbox_available_prefix.test", "prefixes.#", "3"),
+					resource.TestCheckResourceAttr("netbox_available_prefix.test", "prefixes.2", "1.1.30.128/26"),
+					resource.TestCheckResourceAttr("netbox_available_prefix.test", "prefix_ids.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxAvailablePrefix_prefixSelector(t *testing.T) {
+	testSlug := "avail_prefix_sel"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_prefix" "full" {
+  prefix = "1.1.31.0/25"
+  status = "container"
+  tags = [netbox_tag.test.name]
+}
+
+resource "netbox_prefix" "used" {
+  prefix = "1.1.31.0/25"
+  status = "active"
+  depends_on = [netbox_prefix.full]
+}
+
+resource "netbox_prefix" "empty" {
+  prefix = "1.1.31.128/25"
+  status = "container"
+  tags = [netbox_tag.test.name]
+}
+
+resource "netbox_available_prefix" "test" {
+  prefix_selector {
+    tag = netbox_tag.test.slug
+    min_free_percent = 50
+  }
+  prefix_length = 27
+  status = "active"
+  depends_on = [netbox_prefix.full, netbox_prefix.used, netbox_prefix.empty]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_available_prefix.test", "prefix", "1.1.31.128/27"),
+					resource.TestCheckResourceAttrPair("netbox_available_prefix.test", "parent_prefix_id", "netbox_prefix.empty", "id"),
+				),
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_available_prefix", &resource.Sweeper{
 		Name:         "netbox_available_prefix",
@@ -0,0 +1,105 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxAvailableVlan() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxAvailableVlanCreate,
+		Read:   resourceNetboxVlanRead,
+		Update: resourceNetboxVlanUpdate,
+		Delete: resourceNetboxVlanDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):This resource will allocate the next available VLAN ID of a VLAN group and create a VLAN with it.
+
+Available VLAN IDs are those between the ` + "`min_vid`" + ` and ` + "`max_vid`" + ` of the group that are not used by another VLAN of the group.`,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"vid": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The allocated VLAN ID.",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "active",
+				ValidateFunc: validation.StringInSlice(resourceNetboxVlanStatusOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxVlanStatusOptions),
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"role_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"site_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			tagsKey: tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxAvailableVlanCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	groupID := int64(d.Get("group_id").(int))
+
+	data := models.WritableCreateAvailableVLAN{
+		Name:        strToPtr(d.Get("name").(string)),
+		Status:      d.Get("status").(string),
+		Description: d.Get("description").(string),
+		Tenant:      getOptionalInt(d, "tenant_id"),
+		Role:        getOptionalInt(d, "role_id"),
+		Site:        getOptionalInt(d, "site_id"),
+	}
+
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
+	data.Tags = tags
+
+	params := ipam.NewIpamVlanGroupsAvailableVlansCreateParams().WithID(groupID).WithData(&data)
+	res, err := api.Ipam.IpamVlanGroupsAvailableVlansCreate(params, nil)
+	if err != nil {
+		return err
+	}
+	if len(res.GetPayload()) == 0 {
+		return fmt.Errorf("no available VLAN ID in VLAN group %d", groupID)
+	}
+
+	vlan := res.GetPayload()[0]
+	d.SetId(strconv.FormatInt(vlan.ID, 10))
+	d.Set("vid", vlan.Vid)
+
+	return resourceNetboxVlanRead(d, m)
+}
@@ -0,0 +1,78 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccNetboxAvailableVlanFullDependencies(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_tenant" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_vlan_group" "test" {
+  name    = "%[1]s"
+  slug    = "%[1]s"
+  min_vid = 100
+  max_vid = 103
+}
+
+resource "netbox_vlan" "taken" {
+  name     = "%[1]s_taken"
+  vid      = 100
+  group_id = netbox_vlan_group.test.id
+}
+`, testName)
+}
+
+func TestAccNetboxAvailableVlan_basic(t *testing.T) {
+	testSlug := "avail_vlan"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxAvailableVlanFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_available_vlan" "test" {
+  group_id    = netbox_vlan_group.test.id
+  name        = "%[1]s"
+  status      = "reserved"
+  tenant_id   = netbox_tenant.test.id
+  description = "%[1]s_description"
+  tags        = [netbox_tag.test.name]
+  depends_on  = [netbox_vlan.taken]
+}
+
+data "netbox_available_vlans" "test" {
+  group_id   = netbox_vlan_group.test.id
+  depends_on = [netbox_available_vlan.test]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_available_vlan.test", "vid", "101"),
+					resource.TestCheckResourceAttr("netbox_available_vlan.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_available_vlan.test", "status", "reserved"),
+					resource.TestCheckResourceAttr("netbox_available_vlan.test", "description", testName+"_description"),
+					resource.TestCheckResourceAttr("netbox_available_vlan.test", "tags.#", "1"),
+					resource.TestCheckResourceAttrPair("netbox_available_vlan.test", "tenant_id", "netbox_tenant.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_available_vlan.test", "group_id", "netbox_vlan_group.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_available_vlans.test", "vids.#", "2"),
+					resource.TestCheckResourceAttr("data.netbox_available_vlans.test", "vids.0", "102"),
+					resource.TestCheckResourceAttr("data.netbox_available_vlans.test", "vids.1", "103"),
+				),
+			},
+			{
+				ResourceName:      "netbox_available_vlan.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
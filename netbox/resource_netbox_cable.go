@@ -1,6 +1,7 @@
 package netbox
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client"
@@ -80,15 +81,30 @@ func resourceNetboxCable() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			tagsKey:         tagsSchema,
-			customFieldsKey: customFieldsSchema,
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsModeKey: customFieldsModeSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceNetboxCableCustomizeDiff,
 	}
 }
 
+// resourceNetboxCableCustomizeDiff rejects incompatible terminations (e.g. a power port and an interface)
+// during plan instead of failing at apply. The terminations usually reference objects created in the same
+// apply, so their IDs are unknown, but the object types are given literally in the configuration.
+func resourceNetboxCableCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	aTypes := getGenericObjectTypesFromRawConfig(config.GetAttr("a_termination"))
+	bTypes := getGenericObjectTypesFromRawConfig(config.GetAttr("b_termination"))
+	return validateCableTerminationTypes(aTypes, bTypes)
+}
+
 func resourceNetboxCableCreate(d *schema.ResourceData, m interface{}) error {
 	api := m.(*client.NetBoxAPI)
 
@@ -110,11 +126,14 @@ func resourceNetboxCableCreate(d *schema.ResourceData, m interface{}) error {
 	bTerminations := d.Get("b_termination").(*schema.Set)
 	data.BTerminations = getGenericObjectsFromSchemaSet(bTerminations)
 
-	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
+	data.Tags = tags
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
+	if cf := getCustomFieldsForAPI(api, d); cf != nil {
+		data.CustomFields = cf
 	}
 
 	params := dcim.NewDcimCablesCreateParams().WithData(&data)
@@ -178,11 +197,11 @@ func resourceNetboxCableRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("description", cable.Description)
 	d.Set("comments", cable.Comments)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := getCustomFieldsForState(api, d, res.GetPayload().CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getTagListForState(d, res.GetPayload().Tags))
 
 	return nil
 }
@@ -210,16 +229,19 @@ func resourceNetboxCableUpdate(d *schema.ResourceData, m interface{}) error {
 	bTerminations := d.Get("b_termination").(*schema.Set)
 	data.BTerminations = getGenericObjectsFromSchemaSet(bTerminations)
 
-	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
+	data.Tags = tags
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
+	if cf := getCustomFieldsForAPI(api, d); cf != nil {
+		data.CustomFields = cf
 	}
 
 	params := dcim.NewDcimCablesPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Dcim.DcimCablesPartialUpdate(params, nil)
+	_, err = api.Dcim.DcimCablesPartialUpdate(params, nil)
 	if err != nil {
 		return err
 	}
@@ -2,6 +2,7 @@ package netbox
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -202,6 +203,70 @@ resource "netbox_cable" "test" {
 	})
 }
 
+func TestAccNetboxCable_incompatibleTerminations(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "netbox_cable" "test" {
+  a_termination {
+    object_type = "dcim.powerport"
+    object_id = 1
+  }
+  b_termination {
+    object_type = "dcim.interface"
+    object_id = 1
+  }
+  status = "connected"
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("a dcim.powerport cannot be connected to a dcim.interface"),
+			},
+		},
+	})
+}
+
+func TestAccNetboxCable_incompatibleTerminationsReferenced(t *testing.T) {
+	testSlug := "cable_incompat_ref"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// The IDs of the terminations are not known during plan
+				Config: testAccNetboxCableFullDependencies(testName) + `
+resource "netbox_device_power_port" "test" {
+  device_id = netbox_device.test.id
+  name = "power"
+}
+
+resource "netbox_device_interface" "test" {
+  device_id = netbox_device.test.id
+  name = "eth0"
+  type = "1000base-t"
+}
+
+resource "netbox_cable" "test" {
+  a_termination {
+    object_type = "dcim.powerport"
+    object_id = netbox_device_power_port.test.id
+  }
+  b_termination {
+    object_type = "dcim.interface"
+    object_id = netbox_device_interface.test.id
+  }
+  status = "connected"
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("a dcim.powerport cannot be connected to a dcim.interface"),
+			},
+		},
+	})
+}
+
 func testAccCheckCableDestroy(s *terraform.State) error {
 	// retrieve the connection established in Provider configuration
 	conn := testAccProvider.Meta().(*client.NetBoxAPI)
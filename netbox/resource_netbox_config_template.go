@@ -62,7 +62,10 @@ func resourceNetboxConfigTemplateCreate(ctx context.Context, d *schema.ResourceD
 	description := d.Get("description").(string)
 	templateCode := d.Get("template_code").(string)
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	data := models.WritableConfigTemplate{
 		Name:         &name,
@@ -148,7 +151,10 @@ func resourceNetboxConfigTemplateUpdate(ctx context.Context, d *schema.ResourceD
 	description := d.Get("description").(string)
 	templateCode := d.Get("template_code").(string)
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	data := models.WritableConfigTemplate{
 		Name:         &name,
@@ -171,7 +177,7 @@ func resourceNetboxConfigTemplateUpdate(ctx context.Context, d *schema.ResourceD
 	}
 
 	params := extras.NewExtrasConfigTemplatesUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Extras.ExtrasConfigTemplatesUpdate(params, nil)
+	_, err = api.Extras.ExtrasConfigTemplatesUpdate(params, nil)
 	if err != nil {
 		return diag.FromErr(err)
 	}
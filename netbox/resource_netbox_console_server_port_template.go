@@ -0,0 +1,180 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxConsoleServerPortTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxConsoleServerPortTemplateCreate,
+		ReadContext:   resourceNetboxConsoleServerPortTemplateRead,
+		UpdateContext: resourceNetboxConsoleServerPortTemplateUpdate,
+		DeleteContext: resourceNetboxConsoleServerPortTemplateDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/consoleserverporttemplate/):
+
+> A template for a console server port that will be created on all instantiations of the parent device type. See the console server port documentation for more detail.`,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 64),
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "One of [de-9, db-25, rj-11, rj-12, rj-45, mini-din-8, usb-a, usb-b, usb-c, usb-mini-a, usb-mini-b, usb-micro-a, usb-micro-b, usb-micro-ab, other]",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"device_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+				ForceNew:     true,
+			},
+			"module_type_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_type_id", "module_type_id"},
+				ForceNew:     true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxConsoleServerPortTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+
+	data := models.WritableConsoleServerPortTemplate{
+		Name:        strToPtr(d.Get("name").(string)),
+		Label:       d.Get("label").(string),
+		Type:        d.Get("type").(string),
+		Description: d.Get("description").(string),
+		DeviceType:  getOptionalInt(d, "device_type_id"),
+		ModuleType:  getOptionalInt(d, "module_type_id"),
+	}
+
+	params := dcim.NewDcimConsoleServerPortTemplatesCreateParams().WithData(&data)
+
+	res, err := api.Dcim.DcimConsoleServerPortTemplatesCreate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxConsoleServerPortTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxConsoleServerPortTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := dcim.NewDcimConsoleServerPortTemplatesReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimConsoleServerPortTemplatesRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimConsoleServerPortTemplatesReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	tmpl := res.GetPayload()
+
+	d.Set("name", tmpl.Name)
+	d.Set("label", tmpl.Label)
+	if tmpl.Type != nil {
+		d.Set("type", tmpl.Type.Value)
+	} else {
+		d.Set("type", nil)
+	}
+	d.Set("description", tmpl.Description)
+
+	if tmpl.DeviceType != nil {
+		d.Set("device_type_id", tmpl.DeviceType.ID)
+	}
+	if tmpl.ModuleType != nil {
+		d.Set("module_type_id", tmpl.ModuleType.ID)
+	}
+
+	return nil
+}
+
+func resourceNetboxConsoleServerPortTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := models.WritableConsoleServerPortTemplate{
+		Name:        strToPtr(d.Get("name").(string)),
+		Label:       getOptionalStr(d, "label", true),
+		Type:        d.Get("type").(string),
+		Description: getOptionalStr(d, "description", true),
+	}
+
+	params := dcim.NewDcimConsoleServerPortTemplatesPartialUpdateParams().WithID(id).WithData(&data)
+	_, err := api.Dcim.DcimConsoleServerPortTemplatesPartialUpdate(params, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Omitted values are left untouched by the API, so removed values have to be cleared explicitly
+	clearData := map[string]interface{}{}
+	if data.Type == "" && d.HasChange("type") {
+		clearData["type"] = ""
+	}
+	if len(clearData) > 0 {
+		err = netboxRequest(api, http.MethodPatch, fmt.Sprintf("/dcim/console-server-port-templates/%d/", id), nil, clearData, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceNetboxConsoleServerPortTemplateRead(ctx, d, m)
+}
+
+func resourceNetboxConsoleServerPortTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := dcim.NewDcimConsoleServerPortTemplatesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimConsoleServerPortTemplatesDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimConsoleServerPortTemplatesDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -56,7 +56,10 @@ func resourceNetboxContactCreate(d *schema.ResourceData, m interface{}) error {
 	email := d.Get("email").(string)
 	groupID := int64(d.Get("group_id").(int))
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
 
 	data := &models.WritableContact{}
 
@@ -120,7 +123,10 @@ func resourceNetboxContactUpdate(d *schema.ResourceData, m interface{}) error {
 	email := d.Get("email").(string)
 	groupID := int64(d.Get("group_id").(int))
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
 
 	data.Name = &name
 	data.Tags = tags
@@ -132,7 +138,7 @@ func resourceNetboxContactUpdate(d *schema.ResourceData, m interface{}) error {
 
 	params := tenancy.NewTenancyContactsPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Tenancy.TenancyContactsPartialUpdate(params, nil)
+	_, err = api.Tenancy.TenancyContactsPartialUpdate(params, nil)
 	if err != nil {
 		return err
 	}
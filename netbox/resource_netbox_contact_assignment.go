@@ -12,6 +12,26 @@ import (
 
 var resourceNetboxContactAssignmentPriorityOptions = []string{"primary", "secondary", "tertiary", "inactive"}
 
+// resourceNetboxContactAssignmentContentTypes are the object types contacts can be assigned to
+var resourceNetboxContactAssignmentContentTypes = []string{
+	"circuits.circuit",
+	"circuits.provider",
+	"circuits.provideraccount",
+	"dcim.device",
+	"dcim.location",
+	"dcim.manufacturer",
+	"dcim.powerpanel",
+	"dcim.rack",
+	"dcim.region",
+	"dcim.site",
+	"dcim.sitegroup",
+	"tenancy.tenant",
+	"virtualization.cluster",
+	"virtualization.clustergroup",
+	"virtualization.virtualmachine",
+	"vpn.tunnel",
+}
+
 func resourceNetboxContactAssignment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNetboxContactAssignmentCreate,
@@ -25,8 +45,10 @@ func resourceNetboxContactAssignment() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"content_type": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxContactAssignmentContentTypes, false),
+				Description:  buildValidValueDescription(resourceNetboxContactAssignmentContentTypes),
 			},
 			"object_id": {
 				Type:     schema.TypeInt,
@@ -0,0 +1,188 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxDeviceBay() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDeviceBayCreate,
+		Read:   resourceNetboxDeviceBayRead,
+		Update: resourceNetboxDeviceBayUpdate,
+		Delete: resourceNetboxDeviceBayDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/models/dcim/devicebay/):
+
+> Device bays represent a space or slot within a parent device in which a child device may be installed. For example, a 2U parent chassis might house four individual blade servers. The chassis would appear in the rack elevation as a 2U device with four device bays, and each server within it would be defined as a 0U device installed in one of the device bays. Child devices do not appear within rack elevations or count as consuming rack units.
+
+The device type of the device has to have a ` + "`subdevice_role`" + ` of ` + "`parent`" + `. Child devices are installed with the ` + "`netbox_device_bay_installation`" + ` resource.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"installed_device_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsModeKey: customFieldsModeSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDeviceBayCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	data := models.WritableDeviceBay{
+		Device:      int64ToPtr(int64(d.Get("device_id").(int))),
+		Name:        strToPtr(d.Get("name").(string)),
+		Label:       getOptionalStr(d, "label", false),
+		Description: getOptionalStr(d, "description", false),
+	}
+
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
+	data.Tags = tags
+
+	if cf := getCustomFieldsForAPI(api, d); cf != nil {
+		data.CustomFields = cf
+	}
+
+	params := dcim.NewDcimDeviceBaysCreateParams().WithData(&data)
+
+	res, err := api.Dcim.DcimDeviceBaysCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxDeviceBayRead(d, m)
+}
+
+func resourceNetboxDeviceBayRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimDeviceBaysReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimDeviceBaysRead(params, nil)
+
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimDeviceBaysReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	deviceBay := res.GetPayload()
+
+	if deviceBay.Device != nil {
+		d.Set("device_id", deviceBay.Device.ID)
+	} else {
+		d.Set("device_id", nil)
+	}
+
+	d.Set("name", deviceBay.Name)
+	d.Set("label", deviceBay.Label)
+	d.Set("description", deviceBay.Description)
+
+	if deviceBay.InstalledDevice != nil {
+		d.Set("installed_device_id", deviceBay.InstalledDevice.ID)
+	} else {
+		d.Set("installed_device_id", nil)
+	}
+
+	cf := getCustomFieldsForState(api, d, deviceBay.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+	d.Set(tagsKey, getTagListForState(d, deviceBay.Tags))
+
+	return nil
+}
+
+func resourceNetboxDeviceBayUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data := models.WritableDeviceBay{
+		Device:      int64ToPtr(int64(d.Get("device_id").(int))),
+		Name:        strToPtr(d.Get("name").(string)),
+		Label:       getOptionalStr(d, "label", true),
+		Description: getOptionalStr(d, "description", true),
+	}
+
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
+	data.Tags = tags
+
+	if cf := getCustomFieldsForAPI(api, d); cf != nil {
+		data.CustomFields = cf
+	}
+
+	// The installed device is omitted and thereby left untouched, it is managed by netbox_device_bay_installation
+	params := dcim.NewDcimDeviceBaysPartialUpdateParams().WithID(id).WithData(&data)
+
+	_, err = api.Dcim.DcimDeviceBaysPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDeviceBayRead(d, m)
+}
+
+func resourceNetboxDeviceBayDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimDeviceBaysDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimDeviceBaysDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimDeviceBaysDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package netbox
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNetboxDeviceBayInstallation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDeviceBayInstallationCreate,
+		Read:   resourceNetboxDeviceBayInstallationRead,
+		Delete: resourceNetboxDeviceBayInstallationDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):This resource installs a child device into a device bay of its parent device, e.g. a blade into a blade chassis. The device type of the child device has to have a ` + "`subdevice_role`" + ` of ` + "`child`" + `. Netbox moves the child device to the site and rack of its parent.
+
+The ID of this resource is the ID of the device bay. Destroying it removes the child device from the bay, the device itself is kept.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_bay_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"device_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the child device to install.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDeviceBayInstallationCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	deviceBayID := int64(d.Get("device_bay_id").(int))
+	body := map[string]interface{}{
+		"installed_device": int64(d.Get("device_id").(int)),
+	}
+
+	// A raw request is used, because the go-netbox device bay model requires the device and name to be sent along
+	err := netboxRequest(api, http.MethodPatch, fmt.Sprintf("/dcim/device-bays/%d/", deviceBayID), nil, body, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(deviceBayID, 10))
+
+	return resourceNetboxDeviceBayInstallationRead(d, m)
+}
+
+func resourceNetboxDeviceBayInstallationRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimDeviceBaysReadParams().WithID(id)
+
+	res, err := api.Dcim.DcimDeviceBaysRead(params, nil)
+
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimDeviceBaysReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	deviceBay := res.GetPayload()
+
+	// An empty device bay means the child device was removed out of band
+	if deviceBay.InstalledDevice == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("device_bay_id", deviceBay.ID)
+	d.Set("device_id", deviceBay.InstalledDevice.ID)
+
+	return nil
+}
+
+func resourceNetboxDeviceBayInstallationDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	// An omitted installed device is left untouched by the API, so it has to be cleared explicitly
+	body := map[string]interface{}{
+		"installed_device": nil,
+	}
+	err := netboxRequest(api, http.MethodPatch, fmt.Sprintf("/dcim/device-bays/%s/", d.Id()), nil, body, nil)
+	if err != nil {
+		if isNetboxNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	return nil
+}
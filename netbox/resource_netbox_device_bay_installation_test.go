@@ -0,0 +1,74 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxDeviceBayInstallation_basic(t *testing.T) {
+	testSlug := "device_bay_install"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDeviceBayFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_bay" "test" {
+  device_id = netbox_device.parent.id
+  name = "%[1]s"
+}
+
+resource "netbox_device_bay_installation" "test" {
+  device_bay_id = netbox_device_bay.test.id
+  device_id = netbox_device.child.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_device_bay_installation.test", "id", "netbox_device_bay.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_device_bay_installation.test", "device_bay_id", "netbox_device_bay.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_device_bay_installation.test", "device_id", "netbox_device.child", "id"),
+				),
+			},
+			{
+				// The device bay only sees the child device after a refresh
+				Config: testAccNetboxDeviceBayFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_bay" "test" {
+  device_id = netbox_device.parent.id
+  name = "%[1]s"
+}
+
+resource "netbox_device_bay_installation" "test" {
+  device_bay_id = netbox_device_bay.test.id
+  device_id = netbox_device.child.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_device_bay.test", "installed_device_id", "netbox_device.child", "id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_device_bay_installation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNetboxDeviceBayFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_bay" "test" {
+  device_id = netbox_device.parent.id
+  name = "%[1]s"
+}`, testName),
+			},
+			{
+				Config: testAccNetboxDeviceBayFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_device_bay" "test" {
+  device_id = netbox_device.parent.id
+  name = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_bay.test", "installed_device_id", "0"),
+				),
+			},
+		},
+	})
+}
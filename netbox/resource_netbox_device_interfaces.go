@@ -0,0 +1,493 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Number of objects sent to Netbox in a single bulk request
+const deviceInterfacesBulkChunkSize = 50
+
+func resourceNetboxDeviceInterfaces() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetboxDeviceInterfacesCreate,
+		ReadContext:   resourceNetboxDeviceInterfacesRead,
+		UpdateContext: resourceNetboxDeviceInterfacesUpdate,
+		DeleteContext: resourceNetboxDeviceInterfacesDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/features/device/#interface):
+
+> Interfaces in NetBox represent network interfaces used to exchange data with connected devices. On modern networks, these are most commonly Ethernet, but other types are supported as well. IP addresses and VLANs can be assigned to interfaces.
+
+This resource manages the interface set of a single device as one unit. Interfaces are matched by name, so interfaces that already exist on the device (e.g. created from the device type's interface templates) are adopted instead of created again. All changes are sent through Netbox's bulk endpoints.
+
+When the resource is destroyed, only the interfaces it created are deleted. Adopted interfaces are left in place.`,
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"authoritative": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, all interfaces of the device that are not listed in `interface` are deleted.",
+			},
+			"interface": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Set: func(v interface{}) int {
+					return schema.HashString(v.(map[string]interface{})["name"])
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"mgmtonly": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"mac_address": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsMACAddress,
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(resourceNetboxDeviceInterfaceModeOptions, false),
+							Description:  buildValidValueDescription(resourceNetboxDeviceInterfaceModeOptions),
+						},
+						"mtu": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 65536),
+						},
+						"speed": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"tagged_vlans": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+						"untagged_vlan": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						tagsKey: tagsSchema,
+					},
+				},
+			},
+			"interface_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of interface names to the IDs of the managed interfaces.",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"created_interfaces": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The names of the interfaces created by this resource, as opposed to adopted ones. Only these are deleted when the resource is destroyed.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			if d.HasChange("interface") {
+				if err := d.SetNewComputed("created_interfaces"); err != nil {
+					return err
+				}
+				return d.SetNewComputed("interface_ids")
+			}
+			return nil
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				deviceID, err := strconv.ParseInt(d.Id(), 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("expected a device ID, got %q", d.Id())
+				}
+				d.Set("device_id", deviceID)
+				d.Set("authoritative", false)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+}
+
+func resourceNetboxDeviceInterfacesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := resourceNetboxDeviceInterfacesReconcile(d, m)
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(d.Get("device_id").(int)))
+
+	return append(diags, resourceNetboxDeviceInterfacesRead(ctx, d, m)...)
+}
+
+func resourceNetboxDeviceInterfacesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+	deviceID, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	// Netbox rejects the device filter of the interface list if the device does not exist
+	params := dcim.NewDcimDevicesReadParams().WithID(deviceID)
+	_, err := api.Dcim.DcimDevicesRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimDevicesReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	existing, err := getNetboxDeviceInterfacesByName(api, deviceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Unless authoritative, only the interfaces we know about are managed by this resource.
+	// The interface set is empty after an import, in which case we adopt all interfaces.
+	managed := map[string]map[string]interface{}{}
+	configured := d.Get("interface").(*schema.Set).List()
+	for _, v := range configured {
+		managed[v.(map[string]interface{})["name"].(string)] = v.(map[string]interface{})
+	}
+	readAll := d.Get("authoritative").(bool) || len(configured) == 0
+
+	var interfaces []map[string]interface{}
+	interfaceIDs := make(map[string]interface{})
+	var created []string
+	for _, name := range toStringList(d.Get("created_interfaces")) {
+		if _, ok := existing[name]; ok {
+			created = append(created, name)
+		}
+	}
+	for name, iface := range existing {
+		known, ok := managed[name]
+		if !readAll && !ok {
+			continue
+		}
+		var knownTags *schema.Set
+		if ok {
+			knownTags = known[tagsKey].(*schema.Set)
+		}
+		mapping := flattenDeviceInterfacesInterface(iface, knownTags)
+		// Netbox converts MAC addresses always to uppercase
+		if ok && strings.EqualFold(known["mac_address"].(string), mapping["mac_address"].(string)) {
+			mapping["mac_address"] = known["mac_address"]
+		}
+		interfaces = append(interfaces, mapping)
+		interfaceIDs[name] = int(iface.ID)
+	}
+
+	d.Set("device_id", deviceID)
+	d.Set("interface", interfaces)
+	d.Set("interface_ids", interfaceIDs)
+	d.Set("created_interfaces", created)
+
+	return nil
+}
+
+func resourceNetboxDeviceInterfacesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := resourceNetboxDeviceInterfacesReconcile(d, m)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceNetboxDeviceInterfacesRead(ctx, d, m)...)
+}
+
+func resourceNetboxDeviceInterfacesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+
+	// Interfaces that were adopted, e.g. the ones created from the interface templates of the device type, are not ours
+	interfaceIDs := d.Get("interface_ids").(map[string]interface{})
+	var toDelete []map[string]interface{}
+	for _, name := range toStringList(d.Get("created_interfaces")) {
+		if id, ok := interfaceIDs[name]; ok {
+			toDelete = append(toDelete, map[string]interface{}{"id": id})
+		}
+	}
+
+	for _, chunk := range chunkSlice(toDelete, deviceInterfacesBulkChunkSize) {
+		err := netboxRequest(api, http.MethodDelete, "/dcim/interfaces/", nil, chunk, nil)
+		if err != nil && !isNetboxNotFound(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// resourceNetboxDeviceInterfacesReconcile compares the configured interfaces with the interfaces
+// that currently exist on the device (matched by name) and creates, updates and deletes
+// interfaces accordingly.
+func resourceNetboxDeviceInterfacesReconcile(d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	api := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	deviceID := int64(d.Get("device_id").(int))
+	existing, err := getNetboxDeviceInterfacesByName(api, deviceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var toCreate, toUpdate, toDelete []map[string]interface{}
+	desired := map[string]bool{}
+
+	for _, v := range d.Get("interface").(*schema.Set).List() {
+		iface := v.(map[string]interface{})
+		name := iface["name"].(string)
+		desired[name] = true
+
+		current, found := existing[name]
+		if found && deviceInterfacesInterfaceEqual(iface, flattenDeviceInterfacesInterface(current, iface[tagsKey].(*schema.Set))) {
+			continue
+		}
+
+		tags, diagnostics := getNestedTagListFromResourceDataSet(api, iface[tagsKey])
+		if diagnostics != nil {
+			diags = append(diags, diagnostics...)
+		}
+		if diags.HasError() {
+			return diags
+		}
+
+		body := buildDeviceInterfacesRequestBody(deviceID, iface, tags)
+		if found {
+			body["id"] = current.ID
+			toUpdate = append(toUpdate, body)
+		} else {
+			toCreate = append(toCreate, body)
+		}
+	}
+
+	// Interfaces that were removed from the configuration are always deleted,
+	// all other unknown interfaces only in authoritative mode
+	removed := map[string]bool{}
+	if d.HasChange("interface") {
+		old, _ := d.GetChange("interface")
+		for _, v := range old.(*schema.Set).List() {
+			removed[v.(map[string]interface{})["name"].(string)] = true
+		}
+	}
+	authoritative := d.Get("authoritative").(bool)
+	for name, iface := range existing {
+		if desired[name] {
+			continue
+		}
+		if authoritative || removed[name] {
+			toDelete = append(toDelete, map[string]interface{}{"id": iface.ID})
+		}
+	}
+
+	for _, chunk := range chunkSlice(toDelete, deviceInterfacesBulkChunkSize) {
+		if err := netboxRequest(api, http.MethodDelete, "/dcim/interfaces/", nil, chunk, nil); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+	for _, chunk := range chunkSlice(toUpdate, deviceInterfacesBulkChunkSize) {
+		if err := netboxRequest(api, http.MethodPatch, "/dcim/interfaces/", nil, chunk, nil); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+	// The new value is unknown while applying a change of the interfaces
+	oldCreated, _ := d.GetChange("created_interfaces")
+	created := schema.NewSet(schema.HashString, oldCreated.(*schema.Set).List())
+	for _, chunk := range chunkSlice(toCreate, deviceInterfacesBulkChunkSize) {
+		var res []struct {
+			Name string `json:"name"`
+		}
+		if err := netboxRequest(api, http.MethodPost, "/dcim/interfaces/", nil, chunk, &res); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		for _, iface := range res {
+			created.Add(iface.Name)
+		}
+	}
+	d.Set("created_interfaces", created)
+
+	return diags
+}
+
+func getNetboxDeviceInterfacesByName(api *client.NetBoxAPI, deviceID int64) (map[string]*models.Interface, error) {
+	interfaces := make(map[string]*models.Interface)
+
+	deviceIDStr := strconv.FormatInt(deviceID, 10)
+	limit := int64(1000)
+	offset := int64(0)
+	for {
+		params := dcim.NewDcimInterfacesListParams()
+		params.DeviceID = &deviceIDStr
+		params.Limit = &limit
+		params.Offset = &offset
+
+		res, err := api.Dcim.DcimInterfacesList(params, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, iface := range res.GetPayload().Results {
+			interfaces[*iface.Name] = iface
+		}
+
+		offset += int64(len(res.GetPayload().Results))
+		if len(res.GetPayload().Results) == 0 || offset >= *res.GetPayload().Count {
+			break
+		}
+	}
+
+	return interfaces, nil
+}
+
+func buildDeviceInterfacesRequestBody(deviceID int64, iface map[string]interface{}, tags []*models.NestedTag) map[string]interface{} {
+	body := map[string]interface{}{
+		"device":        deviceID,
+		"name":          iface["name"],
+		"type":          iface["type"],
+		"label":         iface["label"],
+		"description":   iface["description"],
+		"enabled":       iface["enabled"],
+		"mgmt_only":     iface["mgmtonly"],
+		"mode":          iface["mode"],
+		"mac_address":   nil,
+		"mtu":           nil,
+		"speed":         nil,
+		"untagged_vlan": nil,
+		"tagged_vlans":  toInt64List(iface["tagged_vlans"]),
+		"tags":          tags,
+	}
+	if macAddress := iface["mac_address"].(string); macAddress != "" {
+		body["mac_address"] = macAddress
+	}
+	if mtu := iface["mtu"].(int); mtu != 0 {
+		body["mtu"] = mtu
+	}
+	if speed := iface["speed"].(int); speed != 0 {
+		body["speed"] = speed
+	}
+	if untaggedVlan := iface["untagged_vlan"].(int); untaggedVlan != 0 {
+		body["untagged_vlan"] = untaggedVlan
+	}
+	return body
+}
+
+// flattenDeviceInterfacesInterface returns the schema representation of an interface. Tags are
+// returned as referenced in knownTags, the configured tags of the interface, which may be nil.
+func flattenDeviceInterfacesInterface(iface *models.Interface, knownTags *schema.Set) map[string]interface{} {
+	mapping := map[string]interface{}{
+		"name":          *iface.Name,
+		"type":          "",
+		"label":         iface.Label,
+		"description":   iface.Description,
+		"enabled":       iface.Enabled,
+		"mgmtonly":      iface.MgmtOnly,
+		"mac_address":   "",
+		"mode":          "",
+		"mtu":           0,
+		"speed":         0,
+		"untagged_vlan": 0,
+		"tagged_vlans":  schema.NewSet(schema.HashInt, nil),
+		tagsKey:         schema.NewSet(schema.HashString, nil),
+	}
+	if iface.Type != nil && iface.Type.Value != nil {
+		mapping["type"] = *iface.Type.Value
+	}
+	if iface.MacAddress != nil {
+		mapping["mac_address"] = *iface.MacAddress
+	}
+	if iface.Mode != nil && iface.Mode.Value != nil {
+		mapping["mode"] = *iface.Mode.Value
+	}
+	if iface.Mtu != nil {
+		mapping["mtu"] = int(*iface.Mtu)
+	}
+	if iface.Speed != nil {
+		mapping["speed"] = int(*iface.Speed)
+	}
+	if iface.UntaggedVlan != nil {
+		mapping["untagged_vlan"] = int(iface.UntaggedVlan.ID)
+	}
+	for _, vlan := range iface.TaggedVlans {
+		mapping["tagged_vlans"].(*schema.Set).Add(int(vlan.ID))
+	}
+	for _, tag := range getTagListForConfiguredTags(knownTags, iface.Tags) {
+		mapping[tagsKey].(*schema.Set).Add(tag)
+	}
+	return mapping
+}
+
+// deviceInterfacesInterfaceEqual compares a configured interface with the flattened representation
+// of an existing interface and returns true if no update is necessary.
+func deviceInterfacesInterfaceEqual(desired, current map[string]interface{}) bool {
+	for _, key := range []string{"name", "type", "label", "description", "enabled", "mgmtonly", "mode", "mtu", "speed", "untagged_vlan"} {
+		if desired[key] != current[key] {
+			return false
+		}
+	}
+	// Netbox converts MAC addresses always to uppercase
+	if !strings.EqualFold(desired["mac_address"].(string), current["mac_address"].(string)) {
+		return false
+	}
+	return setsEqual(desired["tagged_vlans"].(*schema.Set), current["tagged_vlans"].(*schema.Set)) &&
+		setsEqual(desired[tagsKey].(*schema.Set), current[tagsKey].(*schema.Set))
+}
+
+func setsEqual(a, b *schema.Set) bool {
+	aList := make([]string, 0, a.Len())
+	for _, v := range a.List() {
+		aList = append(aList, fmt.Sprint(v))
+	}
+	bList := make([]string, 0, b.Len())
+	for _, v := range b.List() {
+		bList = append(bList, fmt.Sprint(v))
+	}
+	sort.Strings(aList)
+	sort.Strings(bList)
+	return strings.Join(aList, "\x00") == strings.Join(bList, "\x00")
+}
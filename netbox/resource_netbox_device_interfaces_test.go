@@ -0,0 +1,243 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func testAccNetboxDeviceInterfacesDependencies(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_site" "test" {
+  name = "%[1]s"
+  status = "active"
+}
+
+resource "netbox_device_role" "test" {
+  name = "%[1]s"
+  color_hex = "123456"
+}
+
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_device" "test" {
+  name = "%[1]s"
+  device_type_id = netbox_device_type.test.id
+  role_id = netbox_device_role.test.id
+  site_id = netbox_site.test.id
+}
+
+resource "netbox_device_interface" "unmanaged" {
+  name = "unmanaged"
+  device_id = netbox_device.test.id
+  type = "1000base-t"
+}`, testName)
+}
+
+func TestDeviceInterfacesReadDeletedDevice(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/api/dcim/devices/1/" {
+			// Netbox rejects filtering by a device that does not exist
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"device_id": ["Select a valid choice. 1 is not one of the available choices."]}`)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"detail": "No Device matches the given query."}`)
+	}))
+	defer ts.Close()
+
+	config := Config{APIToken: "07b12b765127747e4afd56cb531b7bf9c61f3c30", ServerURL: ts.URL}
+	api, err := config.Client()
+	assert.NoError(t, err)
+
+	d := schema.TestResourceDataRaw(t, resourceNetboxDeviceInterfaces().Schema, map[string]interface{}{
+		"device_id": 1,
+	})
+	d.SetId("1")
+
+	diags := resourceNetboxDeviceInterfacesRead(context.Background(), d, api)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "", d.Id())
+}
+
+func TestDeviceInterfacesDeleteOnlyCreated(t *testing.T) {
+	interfaces := []map[string]interface{}{
+		{"id": 1, "name": "eth0", "type": map[string]interface{}{"value": "1000base-t"}, "enabled": true},
+	}
+	var deleted []map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/dcim/devices/1/":
+			fmt.Fprint(w, `{"id": 1, "name": "test"}`)
+		case r.URL.Path != "/api/dcim/interfaces/":
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(map[string]interface{}{"count": len(interfaces), "results": interfaces})
+		case r.Method == http.MethodPost:
+			var created []map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			for _, iface := range created {
+				iface["id"] = len(interfaces) + 1
+				interfaces = append(interfaces, map[string]interface{}{
+					"id":      iface["id"],
+					"name":    iface["name"],
+					"type":    map[string]interface{}{"value": iface["type"]},
+					"enabled": iface["enabled"],
+				})
+			}
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(created)
+		case r.Method == http.MethodDelete:
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&deleted))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	config := Config{APIToken: "07b12b765127747e4afd56cb531b7bf9c61f3c30", ServerURL: ts.URL}
+	api, err := config.Client()
+	assert.NoError(t, err)
+
+	d := schema.TestResourceDataRaw(t, resourceNetboxDeviceInterfaces().Schema, map[string]interface{}{
+		"device_id": 1,
+		"interface": []interface{}{
+			map[string]interface{}{"name": "eth0", "type": "1000base-t"},
+			map[string]interface{}{"name": "eth1", "type": "1000base-t"},
+		},
+	})
+
+	diags := resourceNetboxDeviceInterfacesCreate(context.Background(), d, api)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, map[string]interface{}{"eth0": 1, "eth1": 2}, d.Get("interface_ids"))
+	assert.Equal(t, []string{"eth1"}, toStringList(d.Get("created_interfaces")))
+
+	diags = resourceNetboxDeviceInterfacesDelete(context.Background(), d, api)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, []map[string]interface{}{{"id": float64(2)}}, deleted)
+}
+
+func TestAccNetboxDeviceInterfaces_basic(t *testing.T) {
+	testSlug := "dev_ifaces_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDeviceInterfacesDependencies(testName) + `
+resource "netbox_device_interfaces" "test" {
+  device_id = netbox_device.test.id
+
+  interface {
+    name = "eth0"
+    type = "1000base-t"
+    mtu  = 1500
+    tags = [netbox_tag.test.name]
+  }
+
+  interface {
+    name        = "eth1"
+    type        = "10gbase-x-sfpp"
+    description = "uplink"
+    mac_address = "0a:0b:0c:0d:0e:0f"
+  }
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_device_interfaces.test", "device_id", "netbox_device.test", "id"),
+					resource.TestCheckResourceAttr("netbox_device_interfaces.test", "interface.#", "2"),
+					resource.TestCheckResourceAttr("netbox_device_interfaces.test", "interface_ids.%", "2"),
+					resource.TestCheckResourceAttr("netbox_device_interfaces.test", "created_interfaces.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("netbox_device_interfaces.test", "interface.*", map[string]string{
+						"name":   "eth0",
+						"mtu":    "1500",
+						"tags.0": testName,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("netbox_device_interfaces.test", "interface.*", map[string]string{
+						"name":        "eth1",
+						"description": "uplink",
+						"mac_address": "0a:0b:0c:0d:0e:0f",
+					}),
+				),
+			},
+			{
+				Config: testAccNetboxDeviceInterfacesDependencies(testName) + `
+resource "netbox_device_interfaces" "test" {
+  device_id = netbox_device.test.id
+
+  interface {
+    name = "eth0"
+    type = "1000base-t"
+    mtu  = 9000
+  }
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_interfaces.test", "interface.#", "1"),
+					resource.TestCheckResourceAttr("netbox_device_interfaces.test", "interface_ids.%", "1"),
+					resource.TestCheckResourceAttr("netbox_device_interfaces.test", "created_interfaces.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("netbox_device_interfaces.test", "interface.*", map[string]string{
+						"name":   "eth0",
+						"mtu":    "9000",
+						"tags.#": "0",
+					}),
+					resource.TestCheckResourceAttr("netbox_device_interface.unmanaged", "name", "unmanaged"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxDeviceInterfaces_authoritative(t *testing.T) {
+	testSlug := "dev_ifaces_auth"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDeviceInterfacesDependencies(testName) + `
+resource "netbox_device_interfaces" "test" {
+  device_id     = netbox_device.test.id
+  authoritative = true
+
+  interface {
+    name = "eth0"
+    type = "1000base-t"
+  }
+
+  depends_on = [netbox_device_interface.unmanaged]
+}`,
+				// the unmanaged interface is deleted, which is detected as drift on refresh
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_interfaces.test", "interface.#", "1"),
+					resource.TestCheckResourceAttr("netbox_device_interfaces.test", "interface_ids.%", "1"),
+					resource.TestCheckResourceAttrSet("netbox_device_interfaces.test", "interface_ids.eth0"),
+				),
+			},
+		},
+	})
+}
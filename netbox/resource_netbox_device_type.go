@@ -1,6 +1,12 @@
 package netbox
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client"
@@ -10,6 +16,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var resourceNetboxDeviceTypeSubdeviceRoleOptions = []string{"parent", "child"}
+var resourceNetboxDeviceTypeAirflowOptions = []string{"front-to-rear", "rear-to-front", "left-to-right", "right-to-left", "side-to-rear", "passive", "mixed"}
+var resourceNetboxDeviceTypeWeightUnitOptions = []string{"kg", "g", "lb", "oz"}
+var resourceNetboxDeviceTypeImages = []string{"front_image", "rear_image"}
+
+// deviceType is a device type as returned by the Netbox API, including the attributes the go-netbox model lacks.
+type deviceType struct {
+	models.DeviceType
+	DefaultPlatform        *netboxNestedObject `json:"default_platform"`
+	ExcludeFromUtilization bool                `json:"exclude_from_utilization"`
+}
+
 func resourceNetboxDeviceType() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNetboxDeviceTypeCreate,
@@ -17,6 +35,8 @@ func resourceNetboxDeviceType() *schema.Resource {
 		Update: resourceNetboxDeviceTypeUpdate,
 		Delete: resourceNetboxDeviceTypeDelete,
 
+		CustomizeDiff: resourceNetboxDeviceTypeCustomizeDiff,
+
 		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):From the [official documentation](https://docs.netbox.dev/en/stable/features/device-types/#device-types_1):
 
 > A device type represents a particular make and model of hardware that exists in the real world. Device types define the physical attributes of a device (rack height and depth) and its individual components (console, power, network interfaces, and so on).`,
@@ -49,7 +69,80 @@ func resourceNetboxDeviceType() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
+			"exclude_from_utilization": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Devices of this type are excluded when calculating rack utilization.",
+			},
+			"subdevice_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxDeviceTypeSubdeviceRoleOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxDeviceTypeSubdeviceRoleOptions) + " Device types need to be parents to have device bays.",
+			},
+			"airflow": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxDeviceTypeAirflowOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxDeviceTypeAirflowOptions),
+			},
+			"weight": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"weight_unit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxDeviceTypeWeightUnitOptions, false),
+				RequiredWith: []string{"weight"},
+				Description:  buildValidValueDescription(resourceNetboxDeviceTypeWeightUnitOptions),
+			},
+			"default_platform_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"front_image": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path to a local image file that is uploaded as the front image. A new upload happens when the path or the content of the file changes.",
+			},
+			"front_image_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-256 checksum of the uploaded front image file.",
+			},
+			"front_image_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rear_image": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path to a local image file that is uploaded as the rear image. A new upload happens when the path or the content of the file changes.",
+			},
+			"rear_image_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-256 checksum of the uploaded rear image file.",
+			},
+			"rear_image_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsModeKey: customFieldsModeSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -60,39 +153,12 @@ func resourceNetboxDeviceType() *schema.Resource {
 func resourceNetboxDeviceTypeCreate(d *schema.ResourceData, m interface{}) error {
 	api := m.(*client.NetBoxAPI)
 
-	data := models.WritableDeviceType{}
-
-	model := d.Get("model").(string)
-	data.Model = &model
-
-	slugValue, slugOk := d.GetOk("slug")
-	// Default slug to generated slug if not given
-	if !slugOk {
-		data.Slug = strToPtr(getSlug(model))
-	} else {
-		data.Slug = strToPtr(slugValue.(string))
-	}
-
-	manufacturerIDValue, ok := d.GetOk("manufacturer_id")
-	if ok {
-		data.Manufacturer = int64ToPtr(int64(manufacturerIDValue.(int)))
-	}
-
-	if partNo, ok := d.GetOk("part_number"); ok {
-		data.PartNumber = partNo.(string)
-	}
-
-	if uHeightValue, ok := d.GetOk("u_height"); ok {
-		data.UHeight = float64ToPtr(float64(uHeightValue.(float64)))
-	}
-
-	if isFullDepthValue, ok := d.GetOk("is_full_depth"); ok {
-		data.IsFullDepth = isFullDepthValue.(bool)
+	data, err := getDeviceTypeFromResourceData(api, d)
+	if err != nil {
+		return err
 	}
 
-	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
-
-	params := dcim.NewDcimDeviceTypesCreateParams().WithData(&data)
+	params := dcim.NewDcimDeviceTypesCreateParams().WithData(data)
 
 	res, err := api.Dcim.DcimDeviceTypesCreate(params, nil)
 	if err != nil {
@@ -101,36 +167,74 @@ func resourceNetboxDeviceTypeCreate(d *schema.ResourceData, m interface{}) error
 
 	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
 
+	err = updateDeviceTypeUnmodeledAttributes(api, d, res.GetPayload().ID, data)
+	if err != nil {
+		return err
+	}
+
 	return resourceNetboxDeviceTypeRead(d, m)
 }
 
 func resourceNetboxDeviceTypeRead(d *schema.ResourceData, m interface{}) error {
 	api := m.(*client.NetBoxAPI)
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimDeviceTypesReadParams().WithID(id)
-
-	res, err := api.Dcim.DcimDeviceTypesRead(params, nil)
 
+	// The go-netbox device type model does not include exclude_from_utilization and default_platform
+	var deviceType deviceType
+	err := netboxRequest(api, http.MethodGet, fmt.Sprintf("/dcim/device-types/%s/", d.Id()), nil, nil, &deviceType)
 	if err != nil {
-		if errresp, ok := err.(*dcim.DcimDeviceTypesReadDefault); ok {
-			errorcode := errresp.Code()
-			if errorcode == 404 {
-				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
-				d.SetId("")
-				return nil
-			}
+		if isNetboxNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
 		}
 		return err
 	}
 
-	deviceType := res.GetPayload()
 	d.Set("model", deviceType.Model)
 	d.Set("slug", deviceType.Slug)
 	d.Set("manufacturer_id", deviceType.Manufacturer.ID)
 	d.Set("part_number", deviceType.PartNumber)
 	d.Set("u_height", deviceType.UHeight)
 	d.Set("is_full_depth", deviceType.IsFullDepth)
-	d.Set(tagsKey, getTagListFromNestedTagList(deviceType.Tags))
+	d.Set("exclude_from_utilization", deviceType.ExcludeFromUtilization)
+	if deviceType.SubdeviceRole != nil {
+		d.Set("subdevice_role", deviceType.SubdeviceRole.Value)
+	} else {
+		d.Set("subdevice_role", nil)
+	}
+	if deviceType.Airflow != nil {
+		d.Set("airflow", deviceType.Airflow.Value)
+	} else {
+		d.Set("airflow", nil)
+	}
+	d.Set("weight", deviceType.Weight)
+	if deviceType.WeightUnit != nil {
+		d.Set("weight_unit", deviceType.WeightUnit.Value)
+	} else {
+		d.Set("weight_unit", nil)
+	}
+	if deviceType.DefaultPlatform != nil {
+		d.Set("default_platform_id", deviceType.DefaultPlatform.ID)
+	} else {
+		d.Set("default_platform_id", nil)
+	}
+	d.Set("front_image_url", deviceType.FrontImage.String())
+	d.Set("rear_image_url", deviceType.RearImage.String())
+	// Images removed outside of Terraform are uploaded again
+	if deviceType.FrontImage == "" {
+		d.Set("front_image_sha256", "")
+	}
+	if deviceType.RearImage == "" {
+		d.Set("rear_image_sha256", "")
+	}
+	d.Set("description", deviceType.Description)
+	d.Set("comments", deviceType.Comments)
+	d.Set(tagsKey, getTagListForState(d, deviceType.Tags))
+
+	cf := getCustomFieldsForState(api, d, deviceType.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
 
 	return nil
 }
@@ -139,6 +243,46 @@ func resourceNetboxDeviceTypeUpdate(d *schema.ResourceData, m interface{}) error
 	api := m.(*client.NetBoxAPI)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	data, err := getDeviceTypeFromResourceData(api, d)
+	if err != nil {
+		return err
+	}
+
+	params := dcim.NewDcimDeviceTypesPartialUpdateParams().WithID(id).WithData(data)
+
+	_, err = api.Dcim.DcimDeviceTypesPartialUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	err = updateDeviceTypeUnmodeledAttributes(api, d, id, data)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDeviceTypeRead(d, m)
+}
+
+func resourceNetboxDeviceTypeDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := dcim.NewDcimDeviceTypesDeleteParams().WithID(id)
+
+	_, err := api.Dcim.DcimDeviceTypesDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*dcim.DcimDeviceTypesDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+func getDeviceTypeFromResourceData(api *client.NetBoxAPI, d *schema.ResourceData) (*models.WritableDeviceType, error) {
 	data := models.WritableDeviceType{}
 
 	model := d.Get("model").(string)
@@ -169,33 +313,136 @@ func resourceNetboxDeviceTypeUpdate(d *schema.ResourceData, m interface{}) error
 		data.IsFullDepth = isFullDepthValue.(bool)
 	}
 
-	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	data.SubdeviceRole = getOptionalStr(d, "subdevice_role", false)
+	data.Airflow = getOptionalStr(d, "airflow", false)
+	data.WeightUnit = getOptionalStr(d, "weight_unit", false)
+	data.Weight = getOptionalFloat(d, "weight")
+	data.Description = getOptionalStr(d, "description", true)
+	data.Comments = getOptionalStr(d, "comments", true)
+
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return nil, err
+	}
+	data.Tags = tags
+
+	if cf := getCustomFieldsForAPI(api, d); cf != nil {
+		data.CustomFields = cf
+	}
+
+	return &data, nil
+}
+
+// updateDeviceTypeUnmodeledAttributes sets the attributes of a device type that cannot be sent with the go-netbox
+// model and uploads its images
+func updateDeviceTypeUnmodeledAttributes(api *client.NetBoxAPI, d *schema.ResourceData, id int64, data *models.WritableDeviceType) error {
+	path := fmt.Sprintf("/dcim/device-types/%d/", id)
 
-	params := dcim.NewDcimDeviceTypesPartialUpdateParams().WithID(id).WithData(&data)
+	// The go-netbox device type model does not include exclude_from_utilization and default_platform
+	body := map[string]interface{}{
+		"exclude_from_utilization": d.Get("exclude_from_utilization").(bool),
+		"default_platform":         getOptionalInt(d, "default_platform_id"),
+	}
 
-	_, err := api.Dcim.DcimDeviceTypesPartialUpdate(params, nil)
+	// Omitted attributes are left untouched by the API, so they have to be cleared explicitly
+	if data.PartNumber == "" && d.HasChange("part_number") {
+		body["part_number"] = ""
+	}
+	if data.SubdeviceRole == "" && d.HasChange("subdevice_role") {
+		body["subdevice_role"] = nil
+	}
+	if data.Airflow == "" && d.HasChange("airflow") {
+		body["airflow"] = nil
+	}
+	if data.Weight == nil && d.HasChange("weight") {
+		body["weight"] = nil
+	}
+	if data.WeightUnit == "" && d.HasChange("weight_unit") {
+		body["weight_unit"] = nil
+	}
+
+	err := netboxRequest(api, http.MethodPatch, path, nil, body, nil)
 	if err != nil {
 		return err
 	}
 
-	return resourceNetboxDeviceTypeRead(d, m)
+	// Images are uploaded and removed with a multipart request, an empty value removes an image
+	images := make(map[string]string)
+	for _, image := range resourceNetboxDeviceTypeImages {
+		if d.HasChange(image) || d.HasChange(image+"_sha256") {
+			images[image] = d.Get(image).(string)
+		}
+	}
+	if len(images) == 0 {
+		return nil
+	}
+
+	var res map[string]interface{}
+	err = netboxUploadFiles(api, path, images, &res)
+	if err != nil {
+		return fmt.Errorf("failed to update images: %w", err)
+	}
+
+	for image, filePath := range images {
+		sum := ""
+		if filePath == "" {
+			if imageURL, ok := res[image].(string); ok && imageURL != "" {
+				return fmt.Errorf("failed to remove %s, it is still set to %s", image, imageURL)
+			}
+		} else {
+			sum, err = getFileSHA256(filePath)
+			if err != nil {
+				return err
+			}
+		}
+		d.Set(image+"_sha256", sum)
+	}
+
+	return nil
 }
 
-func resourceNetboxDeviceTypeDelete(d *schema.ResourceData, m interface{}) error {
-	api := m.(*client.NetBoxAPI)
+// resourceNetboxDeviceTypeCustomizeDiff plans a new upload of an image when the content of its file changed since
+// the last upload.
+func resourceNetboxDeviceTypeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	for _, image := range resourceNetboxDeviceTypeImages {
+		key := image + "_sha256"
+		if !d.NewValueKnown(image) {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+			continue
+		}
 
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := dcim.NewDcimDeviceTypesDeleteParams().WithID(id)
+		sum := ""
+		if filePath := d.Get(image).(string); filePath != "" {
+			var err error
+			sum, err = getFileSHA256(filePath)
+			if os.IsNotExist(err) {
+				// The file may be created during the apply
+				if err := d.SetNewComputed(key); err != nil {
+					return err
+				}
+				continue
+			} else if err != nil {
+				return err
+			}
+		}
 
-	_, err := api.Dcim.DcimDeviceTypesDelete(params, nil)
-	if err != nil {
-		if errresp, ok := err.(*dcim.DcimDeviceTypesDeleteDefault); ok {
-			if errresp.Code() == 404 {
-				d.SetId("")
-				return nil
+		if d.Get(key).(string) != sum {
+			if err := d.SetNew(key, sum); err != nil {
+				return err
 			}
 		}
-		return err
 	}
 	return nil
 }
+
+// getFileSHA256 returns the hex encoded SHA-256 checksum of the content of the local file at filePath.
+func getFileSHA256(filePath string) (string, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
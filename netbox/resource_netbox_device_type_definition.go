@@ -0,0 +1,370 @@
+package netbox
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// deviceTypeDefinitionReference is a related object as returned by Netbox, including its name
+type deviceTypeDefinitionReference struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type deviceTypeDefinitionDeviceType struct {
+	ID            int64                          `json:"id"`
+	Manufacturer  *deviceTypeDefinitionReference `json:"manufacturer"`
+	Model         string                         `json:"model"`
+	Slug          string                         `json:"slug"`
+	PartNumber    string                         `json:"part_number"`
+	UHeight       float64                        `json:"u_height"`
+	IsFullDepth   bool                           `json:"is_full_depth"`
+	Airflow       *netboxStringChoice            `json:"airflow"`
+	SubdeviceRole *netboxStringChoice            `json:"subdevice_role"`
+	Weight        *float64                       `json:"weight"`
+	WeightUnit    *netboxStringChoice            `json:"weight_unit"`
+	Description   string                         `json:"description"`
+	Comments      string                         `json:"comments"`
+}
+
+// deviceTypeDefinitionComponent is a component template of any kind as returned by Netbox
+type deviceTypeDefinitionComponent struct {
+	ID               int64                          `json:"id"`
+	Name             string                         `json:"name"`
+	Label            string                         `json:"label"`
+	Type             *netboxStringChoice            `json:"type"`
+	MgmtOnly         bool                           `json:"mgmt_only"`
+	MaximumDraw      *int64                         `json:"maximum_draw"`
+	AllocatedDraw    *int64                         `json:"allocated_draw"`
+	PowerPort        *deviceTypeDefinitionReference `json:"power_port"`
+	FeedLeg          *netboxStringChoice            `json:"feed_leg"`
+	RearPort         *deviceTypeDefinitionReference `json:"rear_port"`
+	RearPortPosition int64                          `json:"rear_port_position"`
+	Positions        int64                          `json:"positions"`
+	Color            string                         `json:"color"`
+	Position         string                         `json:"position"`
+	Description      string                         `json:"description"`
+}
+
+func (component *deviceTypeDefinitionComponent) definition() *deviceTypeComponentDefinition {
+	definition := &deviceTypeComponentDefinition{
+		Name:             component.Name,
+		Label:            component.Label,
+		MgmtOnly:         component.MgmtOnly,
+		MaximumDraw:      component.MaximumDraw,
+		AllocatedDraw:    component.AllocatedDraw,
+		RearPortPosition: component.RearPortPosition,
+		Positions:        component.Positions,
+		Color:            component.Color,
+		Position:         component.Position,
+		Description:      component.Description,
+	}
+	if component.Type != nil {
+		definition.Type = component.Type.Value
+	}
+	if component.PowerPort != nil {
+		definition.PowerPort = component.PowerPort.Name
+	}
+	if component.FeedLeg != nil {
+		definition.FeedLeg = component.FeedLeg.Value
+	}
+	if component.RearPort != nil {
+		definition.RearPort = component.RearPort.Name
+	}
+	return definition
+}
+
+func resourceNetboxDeviceTypeDefinition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDeviceTypeDefinitionCreate,
+		Read:   resourceNetboxDeviceTypeDefinitionRead,
+		Update: resourceNetboxDeviceTypeDefinitionUpdate,
+		Delete: resourceNetboxDeviceTypeDefinitionDelete,
+
+		Description: `:meta:subcategory:Data Center Inventory Management (DCIM):This resource manages a device type together with all of its component templates from a definition in the format of the [community devicetype-library](https://github.com/netbox-community/devicetype-library).
+
+Interfaces, console ports, console server ports, power ports, power outlets, front ports, rear ports, module bays and device bays are reconciled by name: templates missing from the definition are deleted, new ones are created and changed ones are updated in place. Other keys of the definition, e.g. inventory items or images, are ignored.
+
+The manufacturer is looked up by the name given in the definition and has to exist before the device type is created.`,
+
+		Schema: map[string]*schema.Schema{
+			"definition": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(i interface{}, k string) ([]string, []error) {
+					if _, err := parseDeviceTypeDefinition(i.(string)); err != nil {
+						return nil, []error{err}
+					}
+					return nil, nil
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return equalDeviceTypeDefinitions(old, new)
+				},
+				Description: "The device type in devicetype-library YAML format, e.g. from `file(\"Cisco/C9300-48P.yaml\")`. A decoded definition can be given with `yamlencode`.",
+			},
+			"manufacturer_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"slug": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxDeviceTypeDefinitionCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	definition, err := parseDeviceTypeDefinition(d.Get("definition").(string))
+	if err != nil {
+		return err
+	}
+
+	body, err := getDeviceTypeDefinitionBody(api, definition)
+	if err != nil {
+		return err
+	}
+
+	var deviceType deviceTypeDefinitionDeviceType
+	err = netboxRequest(api, http.MethodPost, "/dcim/device-types/", nil, body, &deviceType)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(deviceType.ID, 10))
+
+	err = reconcileDeviceTypeComponents(api, deviceType.ID, definition)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDeviceTypeDefinitionRead(d, m)
+}
+
+func resourceNetboxDeviceTypeDefinitionRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	var deviceType deviceTypeDefinitionDeviceType
+	err := netboxRequest(api, http.MethodGet, fmt.Sprintf("/dcim/device-types/%d/", id), nil, nil, &deviceType)
+	if err != nil {
+		if isNetboxNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	definition := &deviceTypeDefinition{
+		Model:       deviceType.Model,
+		Slug:        deviceType.Slug,
+		PartNumber:  deviceType.PartNumber,
+		UHeight:     &deviceType.UHeight,
+		IsFullDepth: &deviceType.IsFullDepth,
+		Weight:      deviceType.Weight,
+		Description: deviceType.Description,
+		Comments:    deviceType.Comments,
+	}
+	if deviceType.Manufacturer != nil {
+		definition.Manufacturer = deviceType.Manufacturer.Name
+		d.Set("manufacturer_id", deviceType.Manufacturer.ID)
+	}
+	if deviceType.Airflow != nil {
+		definition.Airflow = deviceType.Airflow.Value
+	}
+	if deviceType.SubdeviceRole != nil {
+		definition.SubdeviceRole = deviceType.SubdeviceRole.Value
+	}
+	if deviceType.WeightUnit != nil {
+		definition.WeightUnit = deviceType.WeightUnit.Value
+	}
+
+	for _, kind := range deviceTypeComponentKinds {
+		components, err := getDeviceTypeComponents(api, kind, id)
+		if err != nil {
+			return err
+		}
+		list := kind.components(definition)
+		for _, component := range components {
+			*list = append(*list, component.definition())
+		}
+	}
+	definition.normalize()
+
+	// Keep the definition as given as long as it describes the device type in Netbox
+	if !equalDeviceTypeDefinitions(d.Get("definition").(string), definition.String()) {
+		d.Set("definition", definition.String())
+	}
+	d.Set("model", deviceType.Model)
+	d.Set("slug", deviceType.Slug)
+
+	return nil
+}
+
+func resourceNetboxDeviceTypeDefinitionUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	definition, err := parseDeviceTypeDefinition(d.Get("definition").(string))
+	if err != nil {
+		return err
+	}
+
+	body, err := getDeviceTypeDefinitionBody(api, definition)
+	if err != nil {
+		return err
+	}
+
+	err = netboxRequest(api, http.MethodPatch, fmt.Sprintf("/dcim/device-types/%d/", id), nil, body, nil)
+	if err != nil {
+		return err
+	}
+
+	err = reconcileDeviceTypeComponents(api, id, definition)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxDeviceTypeDefinitionRead(d, m)
+}
+
+func resourceNetboxDeviceTypeDefinitionDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	// Netbox deletes the component templates together with the device type
+	err := netboxRequest(api, http.MethodDelete, fmt.Sprintf("/dcim/device-types/%d/", id), nil, nil, nil)
+	if err != nil {
+		if isNetboxNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func getDeviceTypeDefinitionBody(api *client.NetBoxAPI, definition *deviceTypeDefinition) (map[string]interface{}, error) {
+	var manufacturer deviceTypeDefinitionReference
+	query := url.Values{"name": []string{definition.Manufacturer}}
+	err := netboxGetSingle(api, "/dcim/manufacturers/", query, "manufacturer", &manufacturer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up manufacturer %q of the device type definition: %w", definition.Manufacturer, err)
+	}
+
+	body := map[string]interface{}{
+		"manufacturer":   manufacturer.ID,
+		"model":          definition.Model,
+		"slug":           definition.Slug,
+		"part_number":    definition.PartNumber,
+		"u_height":       definition.UHeight,
+		"is_full_depth":  definition.IsFullDepth,
+		"airflow":        nil,
+		"subdevice_role": nil,
+		"weight":         definition.Weight,
+		"weight_unit":    nil,
+		"description":    definition.Description,
+		"comments":       definition.Comments,
+	}
+	if definition.Airflow != "" {
+		body["airflow"] = definition.Airflow
+	}
+	if definition.SubdeviceRole != "" {
+		body["subdevice_role"] = definition.SubdeviceRole
+	}
+	if definition.WeightUnit != "" {
+		body["weight_unit"] = definition.WeightUnit
+	}
+	return body, nil
+}
+
+func getDeviceTypeComponents(api *client.NetBoxAPI, kind deviceTypeComponentKind, deviceTypeID int64) ([]*deviceTypeDefinitionComponent, error) {
+	query := url.Values{"device_type_id": []string{strconv.FormatInt(deviceTypeID, 10)}}
+	return netboxListAll[*deviceTypeDefinitionComponent](api, kind.path, query)
+}
+
+// reconcileDeviceTypeComponents makes the component templates of the device type match the definition. Templates
+// are matched by name.
+func reconcileDeviceTypeComponents(api *client.NetBoxAPI, deviceTypeID int64, definition *deviceTypeDefinition) error {
+	// Delete in reverse order first, so that no template is deleted while it is still referenced by another one
+	for i := len(deviceTypeComponentKinds) - 1; i >= 0; i-- {
+		kind := deviceTypeComponentKinds[i]
+		existing, err := getDeviceTypeComponents(api, kind, deviceTypeID)
+		if err != nil {
+			return err
+		}
+
+		wanted := map[string]bool{}
+		for _, component := range *kind.components(definition) {
+			wanted[component.Name] = true
+		}
+		for _, component := range existing {
+			if wanted[component.Name] {
+				continue
+			}
+			err = netboxRequest(api, http.MethodDelete, fmt.Sprintf("%s%d/", kind.path, component.ID), nil, nil, nil)
+			if err != nil && !isNetboxNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	ids := deviceTypeComponentIDs{}
+	for _, kind := range deviceTypeComponentKinds {
+		// Deleting a rear port template deletes its front port templates as well, so the templates are listed again
+		existing, err := getDeviceTypeComponents(api, kind, deviceTypeID)
+		if err != nil {
+			return err
+		}
+		existingByName := map[string]*deviceTypeDefinitionComponent{}
+		for _, component := range existing {
+			existingByName[component.Name] = component
+		}
+
+		ids[kind.key] = map[string]int64{}
+		for _, component := range *kind.components(definition) {
+			body, err := kind.body(component, ids)
+			if err != nil {
+				return err
+			}
+			body["device_type"] = deviceTypeID
+
+			current, ok := existingByName[component.Name]
+			if !ok {
+				var created deviceTypeDefinitionComponent
+				err = netboxRequest(api, http.MethodPost, kind.path, nil, body, &created)
+				if err != nil {
+					return err
+				}
+				ids[kind.key][component.Name] = created.ID
+				continue
+			}
+
+			ids[kind.key][component.Name] = current.ID
+			if reflect.DeepEqual(current.definition(), component) {
+				continue
+			}
+			err = netboxRequest(api, http.MethodPatch, fmt.Sprintf("%s%d/", kind.path, current.ID), nil, body, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package netbox
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccNetboxDeviceTypeDefinitionConfig(testName string, components string) string {
+	return fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type_definition" "test" {
+  definition = <<-EOT
+    manufacturer: ${netbox_manufacturer.test.name}
+    model: %[1]s
+    slug: %[1]s
+    u_height: 2
+    is_full_depth: false
+%[2]s
+  EOT
+}`, testName, components)
+}
+
+func testAccCheckNetboxDeviceTypeDefinitionComponents(kind string, expected ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["netbox_device_type_definition.test"]
+		if !ok {
+			return fmt.Errorf("device type definition not found in state")
+		}
+		id, _ := strconv.ParseInt(rs.Primary.ID, 10, 64)
+
+		for _, k := range deviceTypeComponentKinds {
+			if k.key != kind {
+				continue
+			}
+			api := testAccProvider.Meta().(*client.NetBoxAPI)
+			components, err := getDeviceTypeComponents(api, k, id)
+			if err != nil {
+				return err
+			}
+			names := []string{}
+			for _, component := range components {
+				names = append(names, component.Name)
+			}
+			sort.Strings(names)
+			if !reflect.DeepEqual(names, expected) {
+				return fmt.Errorf("expected %s %v, got %v", kind, expected, names)
+			}
+			return nil
+		}
+		return fmt.Errorf("unknown component kind %s", kind)
+	}
+}
+
+func TestAccNetboxDeviceTypeDefinition_basic(t *testing.T) {
+	testSlug := "dt_definition"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxDeviceTypeDefinitionConfig(testName, `
+    interfaces:
+      - name: eth0
+        type: 1000base-t
+      - name: eth1
+        type: 1000base-t
+      - name: mgmt
+        type: 1000base-t
+        mgmt_only: true
+    console-ports:
+      - name: con0
+        type: rj-45
+    power-ports:
+      - name: PSU1
+        type: iec-60320-c14
+        maximum_draw: 150
+    power-outlets:
+      - name: out1
+        type: iec-60320-c13
+        power_port: PSU1
+    rear-ports:
+      - name: rear
+        type: 8p8c
+        positions: 2
+    front-ports:
+      - name: front1
+        type: 8p8c
+        rear_port: rear
+      - name: front2
+        type: 8p8c
+        rear_port: rear
+        rear_port_position: 2
+    module-bays:
+      - name: slot1
+        position: 1`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_type_definition.test", "model", testName),
+					resource.TestCheckResourceAttr("netbox_device_type_definition.test", "slug", testName),
+					resource.TestCheckResourceAttrPair("netbox_device_type_definition.test", "manufacturer_id", "netbox_manufacturer.test", "id"),
+					testAccCheckNetboxDeviceTypeDefinitionComponents("interfaces", "eth0", "eth1", "mgmt"),
+					testAccCheckNetboxDeviceTypeDefinitionComponents("console-ports", "con0"),
+					testAccCheckNetboxDeviceTypeDefinitionComponents("power-ports", "PSU1"),
+					testAccCheckNetboxDeviceTypeDefinitionComponents("power-outlets", "out1"),
+					testAccCheckNetboxDeviceTypeDefinitionComponents("rear-ports", "rear"),
+					testAccCheckNetboxDeviceTypeDefinitionComponents("front-ports", "front1", "front2"),
+					testAccCheckNetboxDeviceTypeDefinitionComponents("module-bays", "slot1"),
+				),
+			},
+			{
+				Config: testAccNetboxDeviceTypeDefinitionConfig(testName, `
+    interfaces:
+      - name: eth0
+        type: 10gbase-t
+      - name: eth2
+        type: 1000base-t
+    rear-ports:
+      - name: rear
+        type: lc`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetboxDeviceTypeDefinitionComponents("interfaces", "eth0", "eth2"),
+					testAccCheckNetboxDeviceTypeDefinitionComponents("console-ports"),
+					testAccCheckNetboxDeviceTypeDefinitionComponents("power-ports"),
+					testAccCheckNetboxDeviceTypeDefinitionComponents("power-outlets"),
+					testAccCheckNetboxDeviceTypeDefinitionComponents("rear-ports", "rear"),
+					testAccCheckNetboxDeviceTypeDefinitionComponents("front-ports"),
+					testAccCheckNetboxDeviceTypeDefinitionComponents("module-bays"),
+				),
+			},
+			{
+				ResourceName:            "netbox_device_type_definition.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition"},
+			},
+		},
+	})
+}
@@ -1,14 +1,18 @@
 package netbox
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/fbreckle/go-netbox/netbox/client"
 	"github.com/fbreckle/go-netbox/netbox/client/dcim"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccNetboxDeviceType_basic(t *testing.T) {
@@ -74,6 +78,182 @@ resource "netbox_device_type" "test" {
 	})
 }
 
+func TestAccNetboxDeviceType_images(t *testing.T) {
+	testSlug := "device_type_images"
+	testName := testAccGetTestName(testSlug)
+	sum, err := getFileSHA256("testdata/device_type_front.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+  front_image     = "testdata/device_type_front.png"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("netbox_device_type.test", "front_image_url", regexp.MustCompile(`\.png$`)),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "front_image_sha256", sum),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "rear_image_url", ""),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_type.test", "front_image_url", ""),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "front_image_sha256", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestDeviceTypeImageSHA256Diff(t *testing.T) {
+	const image = "testdata/device_type_front.png"
+	sum, err := getFileSHA256(image)
+	assert.NoError(t, err)
+
+	for _, tt := range []struct {
+		name        string
+		image       string
+		oldSHA256   string
+		newSHA256   string
+		newComputed bool
+		changed     bool
+	}{
+		{name: "Unchanged", image: image, oldSHA256: sum},
+		{name: "ContentChanged", image: image, oldSHA256: "0123", newSHA256: sum, changed: true},
+		// Computed attributes cannot be planned as empty, the checksum is cleared during the apply
+		{name: "Removed", oldSHA256: sum, newComputed: true, changed: true},
+		{name: "NotYetCreated", image: "testdata/missing.png", oldSHA256: sum, newComputed: true, changed: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: "1",
+				Attributes: map[string]string{
+					"id":                 "1",
+					"model":              "model",
+					"manufacturer_id":    "1",
+					"front_image":        tt.image,
+					"front_image_sha256": tt.oldSHA256,
+				},
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"model":           "model",
+				"manufacturer_id": 1,
+				"front_image":     tt.image,
+			})
+
+			diff, err := resourceNetboxDeviceType().SimpleDiff(context.Background(), state, config, nil)
+			assert.NoError(t, err)
+
+			attr, changed := diff.Attributes["front_image_sha256"]
+			assert.Equal(t, tt.changed, changed)
+			if changed {
+				assert.Equal(t, tt.newComputed, attr.NewComputed)
+				if !tt.newComputed {
+					assert.Equal(t, tt.newSHA256, attr.New)
+				}
+			}
+		})
+	}
+}
+
+func TestAccNetboxDeviceType_full(t *testing.T) {
+	testSlug := "device_type_full"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_platform" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model                    = "%[1]s"
+  manufacturer_id          = netbox_manufacturer.test.id
+  u_height                 = 2
+  is_full_depth            = true
+  exclude_from_utilization = true
+  subdevice_role           = "parent"
+  airflow                  = "front-to-rear"
+  weight                   = 12.5
+  weight_unit              = "kg"
+  default_platform_id      = netbox_platform.test.id
+  description              = "%[1]s description"
+  comments                 = "%[1]s comments"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_type.test", "u_height", "2"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "exclude_from_utilization", "true"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "subdevice_role", "parent"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "airflow", "front-to-rear"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "weight", "12.5"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "weight_unit", "kg"),
+					resource.TestCheckResourceAttrPair("netbox_device_type.test", "default_platform_id", "netbox_platform.test", "id"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "description", testName+" description"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "comments", testName+" comments"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "front_image_url", ""),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_platform" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model           = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_device_type.test", "exclude_from_utilization", "false"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "subdevice_role", ""),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "airflow", ""),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "weight", "0"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "weight_unit", ""),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "default_platform_id", "0"),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_device_type.test", "comments", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_device_type.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_device_type", &resource.Sweeper{
 		Name:         "netbox_device_type",
@@ -123,7 +123,10 @@ func resourceNetboxEventRuleCreate(d *schema.ResourceData, m interface{}) error
 	data.Enabled = enabled
 	data.ActionObjectID = getOptionalInt(d, "action_object_id")
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
 	data.Tags = tags
 
 	ctypes := d.Get("content_types").(*schema.Set).List()
@@ -193,7 +196,7 @@ func resourceNetboxEventRuleRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("conditions", string(conditions))
 	}
 
-	d.Set(tagsKey, getTagListFromNestedTagList(eventRule.Tags))
+	d.Set(tagsKey, getTagListForState(d, eventRule.Tags))
 
 	return nil
 }
@@ -236,7 +239,10 @@ func resourceNetboxEventRuleUpdate(d *schema.ResourceData, m interface{}) error
 		data.Conditions = conditions
 	}
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
 	data.Tags = tags
 
 	ctypes := d.Get("content_types").(*schema.Set).List()
@@ -248,7 +254,7 @@ func resourceNetboxEventRuleUpdate(d *schema.ResourceData, m interface{}) error
 
 	params := extras.NewExtrasEventRulesUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Extras.ExtrasEventRulesUpdate(params, nil)
+	_, err = api.Extras.ExtrasEventRulesUpdate(params, nil)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,207 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxFhrpGroupProtocolOptions = []string{"vrrp2", "vrrp3", "carp", "clusterxl", "hsrp", "glbp", "other"}
+var resourceNetboxFhrpGroupAuthTypeOptions = []string{"plaintext", "md5"}
+
+func resourceNetboxFhrpGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxFhrpGroupCreate,
+		Read:   resourceNetboxFhrpGroupRead,
+		Update: resourceNetboxFhrpGroupUpdate,
+		Delete: resourceNetboxFhrpGroupDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/models/ipam/fhrpgroup/):
+
+> A first-hop redundancy protocol (FHRP) enables multiple physical interfaces to present a virtual IP address (VIP) in a redundant manner. Examples of such protocols include Virtual Router Redundancy Protocol (VRRP) and Hot Standby Router Protocol (HSRP).
+
+Virtual IP addresses are assigned to a group with the ` + "`netbox_ip_address`" + ` resource, using the ` + "`ipam.fhrpgroup`" + ` object type.`,
+
+		Schema: map[string]*schema.Schema{
+			"protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxFhrpGroupProtocolOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxFhrpGroupProtocolOptions),
+			},
+			"group_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 32767),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"auth_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxFhrpGroupAuthTypeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxFhrpGroupAuthTypeOptions),
+			},
+			"auth_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ip_address_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the virtual IP addresses assigned to this group.",
+			},
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsModeKey: customFieldsModeSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getFhrpGroupFromResourceData(api *client.NetBoxAPI, d *schema.ResourceData) (*models.FHRPGroup, error) {
+	groupID := int64(d.Get("group_id").(int))
+
+	data := models.FHRPGroup{
+		Protocol:    strToPtr(d.Get("protocol").(string)),
+		GroupID:     &groupID,
+		Name:        d.Get("name").(string),
+		AuthType:    d.Get("auth_type").(string),
+		AuthKey:     d.Get("auth_key").(string),
+		Description: d.Get("description").(string),
+		Comments:    d.Get("comments").(string),
+	}
+
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return nil, err
+	}
+	data.Tags = tags
+
+	if cf := getCustomFieldsForAPI(api, d); cf != nil {
+		data.CustomFields = cf
+	}
+
+	return &data, nil
+}
+
+func resourceNetboxFhrpGroupCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	data, err := getFhrpGroupFromResourceData(api, d)
+	if err != nil {
+		return err
+	}
+
+	params := ipam.NewIpamFhrpGroupsCreateParams().WithData(data)
+
+	res, err := api.Ipam.IpamFhrpGroupsCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxFhrpGroupRead(d, m)
+}
+
+func resourceNetboxFhrpGroupRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamFhrpGroupsReadParams().WithID(id)
+
+	res, err := api.Ipam.IpamFhrpGroupsRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*ipam.IpamFhrpGroupsReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	group := res.GetPayload()
+	d.Set("protocol", group.Protocol)
+	d.Set("group_id", group.GroupID)
+	d.Set("name", group.Name)
+	d.Set("auth_type", group.AuthType)
+	d.Set("auth_key", group.AuthKey)
+	d.Set("description", group.Description)
+	d.Set("comments", group.Comments)
+
+	ipAddressIDs := make([]int64, 0, len(group.IPAddresses))
+	for _, ip := range group.IPAddresses {
+		ipAddressIDs = append(ipAddressIDs, ip.ID)
+	}
+	d.Set("ip_address_ids", ipAddressIDs)
+
+	cf := getCustomFieldsForState(api, d, group.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	d.Set(tagsKey, getTagListForState(d, group.Tags))
+	return nil
+}
+
+func resourceNetboxFhrpGroupUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data, err := getFhrpGroupFromResourceData(api, d)
+	if err != nil {
+		return err
+	}
+
+	params := ipam.NewIpamFhrpGroupsUpdateParams().WithID(id).WithData(data)
+
+	_, err = api.Ipam.IpamFhrpGroupsUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxFhrpGroupRead(d, m)
+}
+
+func resourceNetboxFhrpGroupDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamFhrpGroupsDeleteParams().WithID(id)
+
+	_, err := api.Ipam.IpamFhrpGroupsDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*ipam.IpamFhrpGroupsDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
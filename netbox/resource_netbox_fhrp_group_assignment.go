@@ -0,0 +1,159 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxFhrpGroupAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxFhrpGroupAssignmentCreate,
+		Read:   resourceNetboxFhrpGroupAssignmentRead,
+		Update: resourceNetboxFhrpGroupAssignmentUpdate,
+		Delete: resourceNetboxFhrpGroupAssignmentDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/models/ipam/fhrpgroupassignment/):
+
+> This model is used to apply an FHRP group to a router interface, and to indicate the interface's priority in the group.`,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"virtual_machine_interface_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"virtual_machine_interface_id", "device_interface_id"},
+			},
+			"device_interface_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"virtual_machine_interface_id", "device_interface_id"},
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 255),
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getFhrpGroupAssignmentFromResourceData(d *schema.ResourceData) *models.WritableFHRPGroupAssignment {
+	groupID := int64(d.Get("group_id").(int))
+	priority := int64(d.Get("priority").(int))
+
+	data := models.WritableFHRPGroupAssignment{
+		Group:    &groupID,
+		Priority: &priority,
+	}
+
+	vmInterfaceID := getOptionalInt(d, "virtual_machine_interface_id")
+	deviceInterfaceID := getOptionalInt(d, "device_interface_id")
+
+	switch {
+	case vmInterfaceID != nil:
+		data.InterfaceType = strToPtr("virtualization.vminterface")
+		data.InterfaceID = vmInterfaceID
+	case deviceInterfaceID != nil:
+		data.InterfaceType = strToPtr("dcim.interface")
+		data.InterfaceID = deviceInterfaceID
+	}
+
+	return &data
+}
+
+func resourceNetboxFhrpGroupAssignmentCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	params := ipam.NewIpamFhrpGroupAssignmentsCreateParams().WithData(getFhrpGroupAssignmentFromResourceData(d))
+
+	res, err := api.Ipam.IpamFhrpGroupAssignmentsCreate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxFhrpGroupAssignmentRead(d, m)
+}
+
+func resourceNetboxFhrpGroupAssignmentRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamFhrpGroupAssignmentsReadParams().WithID(id)
+
+	res, err := api.Ipam.IpamFhrpGroupAssignmentsRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*ipam.IpamFhrpGroupAssignmentsReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	assignment := res.GetPayload()
+	if assignment.Group != nil {
+		d.Set("group_id", assignment.Group.ID)
+	}
+	d.Set("priority", assignment.Priority)
+
+	if assignment.InterfaceType != nil && assignment.InterfaceID != nil {
+		switch *assignment.InterfaceType {
+		case "virtualization.vminterface":
+			d.Set("virtual_machine_interface_id", assignment.InterfaceID)
+			d.Set("device_interface_id", nil)
+		case "dcim.interface":
+			d.Set("device_interface_id", assignment.InterfaceID)
+			d.Set("virtual_machine_interface_id", nil)
+		}
+	}
+
+	return nil
+}
+
+func resourceNetboxFhrpGroupAssignmentUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	params := ipam.NewIpamFhrpGroupAssignmentsUpdateParams().WithID(id).WithData(getFhrpGroupAssignmentFromResourceData(d))
+
+	_, err := api.Ipam.IpamFhrpGroupAssignmentsUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxFhrpGroupAssignmentRead(d, m)
+}
+
+func resourceNetboxFhrpGroupAssignmentDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamFhrpGroupAssignmentsDeleteParams().WithID(id)
+
+	_, err := api.Ipam.IpamFhrpGroupAssignmentsDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*ipam.IpamFhrpGroupAssignmentsDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxFhrpGroupAssignment_basic(t *testing.T) {
+	testSlug := "fhrp_group_assign"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxIPAddressFullDeviceDependencies(testName) + fmt.Sprintf(`
+resource "netbox_fhrp_group" "test" {
+  name     = "%[1]s"
+  protocol = "hsrp"
+  group_id = 10
+}
+
+resource "netbox_fhrp_group_assignment" "test" {
+  group_id            = netbox_fhrp_group.test.id
+  device_interface_id = netbox_device_interface.test.id
+  priority            = 110
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_fhrp_group_assignment.test", "group_id", "netbox_fhrp_group.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_fhrp_group_assignment.test", "device_interface_id", "netbox_device_interface.test", "id"),
+					resource.TestCheckResourceAttr("netbox_fhrp_group_assignment.test", "priority", "110"),
+				),
+			},
+			{
+				ResourceName:      "netbox_fhrp_group_assignment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,140 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/dcim"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxFrontPortTemplate_basic(t *testing.T) {
+	testSlug := "fpt"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_module_type" "test" {
+  model = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_rear_port_template" "test" {
+  name = "%[1]s"
+  type = "8p8c"
+  positions = 2
+  device_type_id = netbox_device_type.test.id
+}
+
+resource "netbox_front_port_template" "test" {
+  name = "%[1]s"
+  type = "8p8c"
+  rear_port_template_id = netbox_rear_port_template.test.id
+  rear_port_position = 2
+  color_hex = "123456"
+  label = "%[1]s"
+  description = "%[1]s"
+  device_type_id = netbox_device_type.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_front_port_template.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_front_port_template.test", "type", "8p8c"),
+					resource.TestCheckResourceAttr("netbox_front_port_template.test", "rear_port_position", "2"),
+					resource.TestCheckResourceAttr("netbox_front_port_template.test", "color_hex", "123456"),
+					resource.TestCheckResourceAttrPair("netbox_front_port_template.test", "rear_port_template_id", "netbox_rear_port_template.test", "id"),
+					resource.TestCheckResourceAttr("netbox_front_port_template.test", "label", testName),
+					resource.TestCheckResourceAttr("netbox_front_port_template.test", "description", testName),
+					resource.TestCheckResourceAttrPair("netbox_front_port_template.test", "device_type_id", "netbox_device_type.test", "id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_manufacturer" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_device_type" "test" {
+  model = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_module_type" "test" {
+  model = "%[1]s"
+  manufacturer_id = netbox_manufacturer.test.id
+}
+
+resource "netbox_rear_port_template" "test" {
+  name = "%[1]s"
+  type = "8p8c"
+  positions = 2
+  module_type_id = netbox_module_type.test.id
+}
+
+resource "netbox_front_port_template" "test" {
+  name = "%[1]s"
+  type = "8p8c"
+  rear_port_template_id = netbox_rear_port_template.test.id
+  rear_port_position = 2
+  color_hex = "123456"
+  module_type_id = netbox_module_type.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_front_port_template.test", "label", ""),
+					resource.TestCheckResourceAttr("netbox_front_port_template.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_front_port_template.test", "device_type_id", "0"),
+					resource.TestCheckResourceAttrPair("netbox_front_port_template.test", "module_type_id", "netbox_module_type.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "netbox_front_port_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_front_port_template", &resource.Sweeper{
+		Name:         "netbox_front_port_template",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := dcim.NewDcimFrontPortTemplatesListParams()
+			res, err := api.Dcim.DcimFrontPortTemplatesList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, tmpl := range res.GetPayload().Results {
+				if strings.HasPrefix(*tmpl.Name, testPrefix) {
+					deleteParams := dcim.NewDcimFrontPortTemplatesDeleteParams().WithID(tmpl.ID)
+					_, err := api.Dcim.DcimFrontPortTemplatesDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a front port template")
+				}
+			}
+			return nil
+		},
+	})
+}
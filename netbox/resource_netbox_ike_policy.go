@@ -0,0 +1,196 @@
+package netbox
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxIkePolicyVersionOptions = []int{1, 2}
+var resourceNetboxIkePolicyModeOptions = []string{"aggressive", "main"}
+
+// ikePolicy is an IKE policy as returned by the Netbox API. The go-netbox client does not support IKE policies.
+type ikePolicy struct {
+	ID           int64                 `json:"id"`
+	Name         string                `json:"name"`
+	Description  string                `json:"description"`
+	Version      *netboxIntChoice      `json:"version"`
+	Mode         *netboxStringChoice   `json:"mode"`
+	Proposals    []*netboxNestedObject `json:"proposals"`
+	PresharedKey string                `json:"preshared_key"`
+	Comments     string                `json:"comments"`
+	Tags         []*models.NestedTag   `json:"tags"`
+	CustomFields interface{}           `json:"custom_fields"`
+}
+
+func resourceNetboxIkePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxIkePolicyCreate,
+		Read:   resourceNetboxIkePolicyRead,
+		Update: resourceNetboxIkePolicyUpdate,
+		Delete: resourceNetboxIkePolicyDelete,
+
+		Description: `:meta:subcategory:VPN Tunnels:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/ikepolicy/):
+
+> An Internet Key Exchange (IKE) policy defines an IKE version, mode, and set of proposals to be used in IKE negotiation. These policies are referenced by IPSec profiles.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"version": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntInSlice(resourceNetboxIkePolicyVersionOptions),
+				Description:  "The IKE version. " + buildValidIntValueDescription(resourceNetboxIkePolicyVersionOptions),
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxIkePolicyModeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxIkePolicyModeOptions),
+			},
+			"proposal_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the IKE proposals of this policy.",
+			},
+			"preshared_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsModeKey: customFieldsModeSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getIkePolicyRequestBody(api *client.NetBoxAPI, d *schema.ResourceData) (map[string]interface{}, error) {
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"name":          d.Get("name").(string),
+		"version":       d.Get("version").(int),
+		"mode":          d.Get("mode").(string),
+		"proposals":     toInt64List(d.Get("proposal_ids")),
+		"preshared_key": d.Get("preshared_key").(string),
+		"description":   d.Get("description").(string),
+		"comments":      d.Get("comments").(string),
+		"tags":          tags,
+	}
+	if cf := getCustomFieldsForAPI(api, d); cf != nil {
+		body["custom_fields"] = cf
+	}
+	return body, nil
+}
+
+func resourceNetboxIkePolicyCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	body, err := getIkePolicyRequestBody(api, d)
+	if err != nil {
+		return err
+	}
+
+	var res ikePolicy
+	err = netboxRequest(api, http.MethodPost, "/vpn/ike-policies/", nil, body, &res)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.ID, 10))
+
+	return resourceNetboxIkePolicyRead(d, m)
+}
+
+func resourceNetboxIkePolicyRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	var policy ikePolicy
+	err := netboxRequest(api, http.MethodGet, fmt.Sprintf("/vpn/ike-policies/%s/", d.Id()), nil, nil, &policy)
+	if err != nil {
+		if isNetboxNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", policy.Name)
+	if policy.Version != nil {
+		d.Set("version", policy.Version.Value)
+	}
+	if policy.Mode != nil {
+		d.Set("mode", policy.Mode.Value)
+	} else {
+		d.Set("mode", nil)
+	}
+	d.Set("proposal_ids", getIDsFromNetboxNestedObjects(policy.Proposals))
+	d.Set("preshared_key", policy.PresharedKey)
+	d.Set("description", policy.Description)
+	d.Set("comments", policy.Comments)
+
+	cf := getCustomFieldsForState(api, d, policy.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	d.Set(tagsKey, getTagListForState(d, policy.Tags))
+	return nil
+}
+
+func resourceNetboxIkePolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	body, err := getIkePolicyRequestBody(api, d)
+	if err != nil {
+		return err
+	}
+
+	err = netboxRequest(api, http.MethodPatch, fmt.Sprintf("/vpn/ike-policies/%s/", d.Id()), nil, body, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxIkePolicyRead(d, m)
+}
+
+func resourceNetboxIkePolicyDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	err := netboxRequest(api, http.MethodDelete, fmt.Sprintf("/vpn/ike-policies/%s/", d.Id()), nil, nil, nil)
+	if err != nil {
+		if isNetboxNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	return nil
+}
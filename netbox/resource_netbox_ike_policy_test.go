@@ -0,0 +1,101 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxIkePolicy_basic(t *testing.T) {
+	testSlug := "ike_pol"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_ike_proposal" "test" {
+  name                  = "%[1]s"
+  authentication_method = "preshared-keys"
+  encryption_algorithm  = "aes-256-cbc"
+  group                 = 14
+}
+
+resource "netbox_ike_policy" "test" {
+  name          = "%[1]s"
+  version       = 2
+  proposal_ids  = [netbox_ike_proposal.test.id]
+  preshared_key = "%[1]s_secret"
+  description   = "%[1]s_description"
+  comments      = "%[1]s_comments"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ike_policy.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_ike_policy.test", "version", "2"),
+					resource.TestCheckResourceAttr("netbox_ike_policy.test", "mode", ""),
+					resource.TestCheckResourceAttr("netbox_ike_policy.test", "proposal_ids.#", "1"),
+					resource.TestCheckResourceAttr("netbox_ike_policy.test", "preshared_key", testName+"_secret"),
+					resource.TestCheckResourceAttr("netbox_ike_policy.test", "description", testName+"_description"),
+					resource.TestCheckResourceAttr("netbox_ike_policy.test", "comments", testName+"_comments"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_ike_policy" "test" {
+  name    = "%[1]s"
+  version = 1
+  mode    = "main"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ike_policy.test", "version", "1"),
+					resource.TestCheckResourceAttr("netbox_ike_policy.test", "mode", "main"),
+					resource.TestCheckResourceAttr("netbox_ike_policy.test", "proposal_ids.#", "0"),
+					resource.TestCheckResourceAttr("netbox_ike_policy.test", "preshared_key", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_ike_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_ike_policy", &resource.Sweeper{
+		Name:         "netbox_ike_policy",
+		Dependencies: []string{"netbox_ipsec_profile"},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			var res struct {
+				Results []*ikePolicy `json:"results"`
+			}
+			err = netboxRequest(api, http.MethodGet, "/vpn/ike-policies/", nil, nil, &res)
+			if err != nil {
+				return err
+			}
+			for _, policy := range res.Results {
+				if !strings.HasPrefix(policy.Name, testPrefix) {
+					continue
+				}
+				err := netboxRequest(api, http.MethodDelete, fmt.Sprintf("/vpn/ike-policies/%d/", policy.ID), nil, nil, nil)
+				if err != nil {
+					return err
+				}
+				log.Print("[DEBUG] Deleted an ike policy")
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,213 @@
+package netbox
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxIkeProposalAuthenticationMethodOptions = []string{"preshared-keys", "certificates", "rsa-signatures", "dsa-signatures"}
+
+// These options are shared by IKE and IPsec proposals and policies
+var resourceNetboxVpnEncryptionAlgorithmOptions = []string{"aes-128-cbc", "aes-128-gcm", "aes-192-cbc", "aes-192-gcm", "aes-256-cbc", "aes-256-gcm", "3des-cbc", "des-cbc"}
+var resourceNetboxVpnAuthenticationAlgorithmOptions = []string{"hmac-sha1", "hmac-sha256", "hmac-sha384", "hmac-sha512", "hmac-md5"}
+var resourceNetboxVpnDHGroupOptions = []int{1, 2, 5, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34}
+
+// ikeProposal is an IKE proposal as returned by the Netbox API. The go-netbox client does not support IKE proposals.
+type ikeProposal struct {
+	ID                      int64               `json:"id"`
+	Name                    string              `json:"name"`
+	Description             string              `json:"description"`
+	AuthenticationMethod    *netboxStringChoice `json:"authentication_method"`
+	EncryptionAlgorithm     *netboxStringChoice `json:"encryption_algorithm"`
+	AuthenticationAlgorithm *netboxStringChoice `json:"authentication_algorithm"`
+	Group                   *netboxIntChoice    `json:"group"`
+	SaLifetime              *int64              `json:"sa_lifetime"`
+	Comments                string              `json:"comments"`
+	Tags                    []*models.NestedTag `json:"tags"`
+	CustomFields            interface{}         `json:"custom_fields"`
+}
+
+func resourceNetboxIkeProposal() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxIkeProposalCreate,
+		Read:   resourceNetboxIkeProposalRead,
+		Update: resourceNetboxIkeProposalUpdate,
+		Delete: resourceNetboxIkeProposalDelete,
+
+		Description: `:meta:subcategory:VPN Tunnels:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/ikeproposal/):
+
+> An Internet Key Exchange (IKE) proposal defines a set of parameters used to establish a secure bidirectional connection across an untrusted medium, such as the Internet. IKE proposals defined in NetBox can be referenced by IKE policies, which can in turn be referenced by IPSec profiles.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"authentication_method": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxIkeProposalAuthenticationMethodOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxIkeProposalAuthenticationMethodOptions),
+			},
+			"encryption_algorithm": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxVpnEncryptionAlgorithmOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxVpnEncryptionAlgorithmOptions),
+			},
+			"authentication_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxVpnAuthenticationAlgorithmOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxVpnAuthenticationAlgorithmOptions),
+			},
+			"group": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntInSlice(resourceNetboxVpnDHGroupOptions),
+				Description:  "The Diffie-Hellman group. " + buildValidIntValueDescription(resourceNetboxVpnDHGroupOptions),
+			},
+			"sa_lifetime": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The security association lifetime in seconds.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsModeKey: customFieldsModeSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getIkeProposalRequestBody(api *client.NetBoxAPI, d *schema.ResourceData) (map[string]interface{}, error) {
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"name":                     d.Get("name").(string),
+		"authentication_method":    d.Get("authentication_method").(string),
+		"encryption_algorithm":     d.Get("encryption_algorithm").(string),
+		"authentication_algorithm": d.Get("authentication_algorithm").(string),
+		"group":                    d.Get("group").(int),
+		"sa_lifetime":              getOptionalInt(d, "sa_lifetime"),
+		"description":              d.Get("description").(string),
+		"comments":                 d.Get("comments").(string),
+		"tags":                     tags,
+	}
+	if cf := getCustomFieldsForAPI(api, d); cf != nil {
+		body["custom_fields"] = cf
+	}
+	return body, nil
+}
+
+func resourceNetboxIkeProposalCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	body, err := getIkeProposalRequestBody(api, d)
+	if err != nil {
+		return err
+	}
+
+	var res ikeProposal
+	err = netboxRequest(api, http.MethodPost, "/vpn/ike-proposals/", nil, body, &res)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.ID, 10))
+
+	return resourceNetboxIkeProposalRead(d, m)
+}
+
+func resourceNetboxIkeProposalRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	var proposal ikeProposal
+	err := netboxRequest(api, http.MethodGet, fmt.Sprintf("/vpn/ike-proposals/%s/", d.Id()), nil, nil, &proposal)
+	if err != nil {
+		if isNetboxNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", proposal.Name)
+	if proposal.AuthenticationMethod != nil {
+		d.Set("authentication_method", proposal.AuthenticationMethod.Value)
+	}
+	if proposal.EncryptionAlgorithm != nil {
+		d.Set("encryption_algorithm", proposal.EncryptionAlgorithm.Value)
+	}
+	if proposal.AuthenticationAlgorithm != nil {
+		d.Set("authentication_algorithm", proposal.AuthenticationAlgorithm.Value)
+	} else {
+		d.Set("authentication_algorithm", nil)
+	}
+	if proposal.Group != nil {
+		d.Set("group", proposal.Group.Value)
+	}
+	d.Set("sa_lifetime", proposal.SaLifetime)
+	d.Set("description", proposal.Description)
+	d.Set("comments", proposal.Comments)
+
+	cf := getCustomFieldsForState(api, d, proposal.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	d.Set(tagsKey, getTagListForState(d, proposal.Tags))
+	return nil
+}
+
+func resourceNetboxIkeProposalUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	body, err := getIkeProposalRequestBody(api, d)
+	if err != nil {
+		return err
+	}
+
+	err = netboxRequest(api, http.MethodPatch, fmt.Sprintf("/vpn/ike-proposals/%s/", d.Id()), nil, body, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxIkeProposalRead(d, m)
+}
+
+func resourceNetboxIkeProposalDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	err := netboxRequest(api, http.MethodDelete, fmt.Sprintf("/vpn/ike-proposals/%s/", d.Id()), nil, nil, nil)
+	if err != nil {
+		if isNetboxNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxIkeProposal_basic(t *testing.T) {
+	testSlug := "ike_prop"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_ike_proposal" "test" {
+  name                     = "%[1]s"
+  authentication_method    = "preshared-keys"
+  encryption_algorithm     = "aes-256-cbc"
+  authentication_algorithm = "hmac-sha256"
+  group                    = 14
+  sa_lifetime              = 28800
+  description              = "%[1]s_description"
+  comments                 = "%[1]s_comments"
+  tags                     = [netbox_tag.test.name]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "authentication_method", "preshared-keys"),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "encryption_algorithm", "aes-256-cbc"),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "authentication_algorithm", "hmac-sha256"),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "group", "14"),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "sa_lifetime", "28800"),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "description", testName+"_description"),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "comments", testName+"_comments"),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "tags.#", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_ike_proposal" "test" {
+  name                  = "%[1]s"
+  authentication_method = "certificates"
+  encryption_algorithm  = "aes-128-gcm"
+  group                 = 19
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "authentication_method", "certificates"),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "authentication_algorithm", ""),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "group", "19"),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "sa_lifetime", "0"),
+					resource.TestCheckResourceAttr("netbox_ike_proposal.test", "tags.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_ike_proposal.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_ike_proposal", &resource.Sweeper{
+		Name:         "netbox_ike_proposal",
+		Dependencies: []string{"netbox_ike_policy"},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			var res struct {
+				Results []*ikeProposal `json:"results"`
+			}
+			err = netboxRequest(api, http.MethodGet, "/vpn/ike-proposals/", nil, nil, &res)
+			if err != nil {
+				return err
+			}
+			for _, proposal := range res.Results {
+				if !strings.HasPrefix(proposal.Name, testPrefix) {
+					continue
+				}
+				err := netboxRequest(api, http.MethodDelete, fmt.Sprintf("/vpn/ike-proposals/%d/", proposal.ID), nil, nil, nil)
+				if err != nil {
+					return err
+				}
+				log.Print("[DEBUG] Deleted an ike proposal")
+			}
+			return nil
+		},
+	})
+}
@@ -100,6 +100,9 @@ func resourceNetboxInterfaceCreate(ctx context.Context, d *schema.ResourceData,
 	if diagnostics != nil {
 		diags = append(diags, diagnostics...)
 	}
+	if diags.HasError() {
+		return diags
+	}
 	taggedVlans := toInt64List(d.Get("tagged_vlans"))
 	virtualMachineID := int64(d.Get("virtual_machine_id").(int))
 
@@ -161,7 +164,7 @@ func resourceNetboxInterfaceRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("enabled", iface.Enabled)
 	d.Set("mac_address", iface.MacAddress)
 	d.Set("mtu", iface.Mtu)
-	d.Set(tagsKey, getTagListFromNestedTagList(iface.Tags))
+	d.Set(tagsKey, getTagListForState(d, iface.Tags))
 	d.Set("tagged_vlans", getIDsFromNestedVLAN(iface.TaggedVlans))
 	d.Set("virtual_machine_id", iface.VirtualMachine.ID)
 
@@ -190,6 +193,9 @@ func resourceNetboxInterfaceUpdate(ctx context.Context, d *schema.ResourceData,
 	if diagnostics != nil {
 		diags = append(diags, diagnostics...)
 	}
+	if diags.HasError() {
+		return diags
+	}
 	taggedVlans := toInt64List(d.Get("tagged_vlans"))
 	virtualMachineID := int64(d.Get("virtual_machine_id").(int))
 
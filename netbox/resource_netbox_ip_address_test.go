@@ -393,6 +393,41 @@ resource "netbox_ip_address" "test" {
 	})
 }
 
+func TestAccNetboxIPAddress_ipv6Notation(t *testing.T) {
+	testIP := "2001:DB8:0::a1/64"
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_ip_address" "test" {
+  ip_address = "%s"
+  status = "active"
+}`, testIP),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ip_address.test", "ip_address", "2001:db8::a1/64"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_ip_address" "test" {
+  ip_address = "%s"
+  status = "active"
+}`, testIP),
+				PlanOnly: true,
+			},
+			{
+				Config: `
+resource "netbox_ip_address" "test" {
+  ip_address = "2001:db8::a1"
+  status = "active"
+}`,
+				ExpectError: regexp.MustCompile("expected ip_address to be an IP address in CIDR notation"),
+			},
+		},
+	})
+}
+
 func TestAccNetboxIPAddress_nat(t *testing.T) {
 	testIP := "1.1.1.10/32"
 	testIPInside := "1.1.1.11/32"
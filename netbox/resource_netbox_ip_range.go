@@ -1,6 +1,8 @@
 package netbox
 
 import (
+	"fmt"
+	"net/http"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client"
@@ -12,6 +14,12 @@ import (
 
 var resourceNetboxIPRangeStatusOptions = []string{"active", "reserved", "deprecated"}
 
+// ipRange is an IP range as returned by the Netbox API, including the attributes the go-netbox model lacks.
+type ipRange struct {
+	models.IPRange
+	MarkUtilized bool `json:"mark_utilized"`
+}
+
 func resourceNetboxIPRange() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNetboxIPRangeCreate,
@@ -25,12 +33,16 @@ func resourceNetboxIPRange() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"start_address": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateIPAddressWithMask,
+				DiffSuppressFunc: suppressEquivalentCIDRDiff,
 			},
 			"end_address": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateIPAddressWithMask,
+				DiffSuppressFunc: suppressEquivalentCIDRDiff,
 			},
 			"status": {
 				Type:         schema.TypeString,
@@ -55,7 +67,19 @@ func resourceNetboxIPRange() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			tagsKey: tagsSchema,
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"mark_utilized": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Treat the range as 100% utilized.",
+			},
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsModeKey: customFieldsModeSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -77,7 +101,11 @@ func resourceNetboxIPRangeCreate(d *schema.ResourceData, m interface{}) error {
 	data.Status = status
 	data.Description = description
 
-	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
+	data.Tags = tags
 
 	params := ipam.NewIpamIPRangesCreateParams().WithData(&data)
 	res, err := api.Ipam.IpamIPRangesCreate(params, nil)
@@ -91,51 +119,59 @@ func resourceNetboxIPRangeCreate(d *schema.ResourceData, m interface{}) error {
 
 func resourceNetboxIPRangeRead(d *schema.ResourceData, m interface{}) error {
 	api := m.(*client.NetBoxAPI)
-	id, _ := strconv.ParseInt(d.Id(), 10, 64)
-	params := ipam.NewIpamIPRangesReadParams().WithID(id)
 
-	res, err := api.Ipam.IpamIPRangesRead(params, nil)
+	// The go-netbox IP range model does not include mark_utilized
+	var rng ipRange
+	err := netboxRequest(api, http.MethodGet, fmt.Sprintf("/ipam/ip-ranges/%s/", d.Id()), nil, nil, &rng)
 	if err != nil {
-		if errresp, ok := err.(*ipam.IpamIPRangesReadDefault); ok {
-			errorcode := errresp.Code()
-			if errorcode == 404 {
-				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
-				d.SetId("")
-				return nil
-			}
+		if isNetboxNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
 		}
 		return err
 	}
 
-	if res.GetPayload().StartAddress != nil {
-		d.Set("start_address", res.GetPayload().StartAddress)
+	if rng.StartAddress != nil {
+		d.Set("start_address", rng.StartAddress)
 	}
 
-	if res.GetPayload().EndAddress != nil {
-		d.Set("end_address", res.GetPayload().EndAddress)
+	if rng.EndAddress != nil {
+		d.Set("end_address", rng.EndAddress)
 	}
 
-	if res.GetPayload().Status != nil {
-		d.Set("status", res.GetPayload().Status.Value)
+	if rng.Status != nil {
+		d.Set("status", rng.Status.Value)
 	}
 
-	if res.GetPayload().Vrf != nil {
-		d.Set("vrf_id", res.GetPayload().Vrf.ID)
+	if rng.Vrf != nil {
+		d.Set("vrf_id", rng.Vrf.ID)
+	} else {
+		d.Set("vrf_id", nil)
 	}
 
-	if res.GetPayload().Description != "" {
-		d.Set("description", res.GetPayload().Description)
+	d.Set("description", rng.Description)
+	d.Set("comments", rng.Comments)
+	d.Set("mark_utilized", rng.MarkUtilized)
+
+	if rng.Tenant != nil {
+		d.Set("tenant_id", rng.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
 	}
 
-	if res.GetPayload().Tenant != nil {
-		d.Set("tenant_id", res.GetPayload().Tenant.ID)
+	if rng.Role != nil {
+		d.Set("role_id", rng.Role.ID)
+	} else {
+		d.Set("role_id", nil)
 	}
 
-	if res.GetPayload().Role != nil {
-		d.Set("role_id", res.GetPayload().Role.ID)
+	cf := getCustomFieldsForState(api, d, rng.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
 	}
 
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getTagListForState(d, rng.Tags))
 
 	return nil
 }
@@ -154,6 +190,7 @@ func resourceNetboxIPRangeUpdate(d *schema.ResourceData, m interface{}) error {
 
 	data.Status = status
 	data.Description = description
+	data.Comments = d.Get("comments").(string)
 
 	if vrfID, ok := d.GetOk("vrf_id"); ok {
 		data.Vrf = int64ToPtr(int64(vrfID.(int)))
@@ -167,13 +204,36 @@ func resourceNetboxIPRangeUpdate(d *schema.ResourceData, m interface{}) error {
 		data.Role = int64ToPtr(int64(roleID.(int)))
 	}
 
-	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
+	data.Tags = tags
+
+	if cf := getCustomFieldsForAPI(api, d); cf != nil {
+		data.CustomFields = cf
+	}
 
 	params := ipam.NewIpamIPRangesUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Ipam.IpamIPRangesUpdate(params, nil)
+	_, err = api.Ipam.IpamIPRangesUpdate(params, nil)
 	if err != nil {
 		return err
 	}
+
+	// The go-netbox IP range model does not include mark_utilized and omits empty comments
+	body := map[string]interface{}{}
+	if d.HasChange("mark_utilized") {
+		body["mark_utilized"] = d.Get("mark_utilized").(bool)
+	}
+	if d.HasChange("comments") && data.Comments == "" {
+		body["comments"] = ""
+	}
+	if len(body) > 0 {
+		err = netboxRequest(api, http.MethodPatch, fmt.Sprintf("/ipam/ip-ranges/%d/", id), nil, body, nil)
+		if err != nil {
+			return err
+		}
+	}
 	return resourceNetboxIPRangeRead(d, m)
 }
 
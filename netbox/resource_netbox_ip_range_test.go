@@ -138,6 +138,64 @@ resource "netbox_ip_range" "test_with_dependencies" {
 	})
 }
 
+func TestAccNetboxIpRange_markUtilized(t *testing.T) {
+	testSlug := "range_util"
+	testName := testAccGetTestName(testSlug)
+	testStartAddress := "10.42.0.10/24"
+	testEndAddress := "10.42.0.20/24"
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_custom_field" "test" {
+  name          = "%[1]s"
+  type          = "text"
+  content_types = ["ipam.iprange"]
+}
+
+resource "netbox_ip_range" "test" {
+  start_address = "%[2]s"
+  end_address   = "%[3]s"
+  mark_utilized = true
+  comments      = "%[1]s_comments"
+  custom_fields = {
+    "${netbox_custom_field.test.name}" = "test value"
+  }
+}`, testName, testStartAddress, testEndAddress),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ip_range.test", "mark_utilized", "true"),
+					resource.TestCheckResourceAttr("netbox_ip_range.test", "comments", testName+"_comments"),
+					resource.TestCheckResourceAttr("netbox_ip_range.test", "custom_fields."+testName, "test value"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_custom_field" "test" {
+  name          = "%[1]s"
+  type          = "text"
+  content_types = ["ipam.iprange"]
+}
+
+resource "netbox_ip_range" "test" {
+  start_address = "%[2]s"
+  end_address   = "%[3]s"
+}`, testName, testStartAddress, testEndAddress),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ip_range.test", "mark_utilized", "false"),
+					resource.TestCheckResourceAttr("netbox_ip_range.test", "comments", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_ip_range.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_ip_range", &resource.Sweeper{
 		Name:         "netbox_ip_range",
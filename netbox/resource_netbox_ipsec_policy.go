@@ -0,0 +1,174 @@
+package netbox
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ipsecPolicy is an IPsec policy as returned by the Netbox API. The go-netbox client does not support IPsec policies.
+type ipsecPolicy struct {
+	ID           int64                 `json:"id"`
+	Name         string                `json:"name"`
+	Description  string                `json:"description"`
+	Proposals    []*netboxNestedObject `json:"proposals"`
+	PfsGroup     *netboxIntChoice      `json:"pfs_group"`
+	Comments     string                `json:"comments"`
+	Tags         []*models.NestedTag   `json:"tags"`
+	CustomFields interface{}           `json:"custom_fields"`
+}
+
+func resourceNetboxIpsecPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxIpsecPolicyCreate,
+		Read:   resourceNetboxIpsecPolicyRead,
+		Update: resourceNetboxIpsecPolicyUpdate,
+		Delete: resourceNetboxIpsecPolicyDelete,
+
+		Description: `:meta:subcategory:VPN Tunnels:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/ipsecpolicy/):
+
+> An IPSec policy defines a set of proposals to be used in the formation of IPSec tunnels. A perfect forward secrecy (PFS) group may optionally also be defined. These policies are referenced by IPSec profiles.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"proposal_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the IPsec proposals of this policy.",
+			},
+			"pfs_group": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntInSlice(resourceNetboxVpnDHGroupOptions),
+				Description:  "The Diffie-Hellman group for perfect forward secrecy. " + buildValidIntValueDescription(resourceNetboxVpnDHGroupOptions),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsModeKey: customFieldsModeSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getIpsecPolicyRequestBody(api *client.NetBoxAPI, d *schema.ResourceData) (map[string]interface{}, error) {
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"proposals":   toInt64List(d.Get("proposal_ids")),
+		"pfs_group":   getOptionalInt(d, "pfs_group"),
+		"description": d.Get("description").(string),
+		"comments":    d.Get("comments").(string),
+		"tags":        tags,
+	}
+	if cf := getCustomFieldsForAPI(api, d); cf != nil {
+		body["custom_fields"] = cf
+	}
+	return body, nil
+}
+
+func resourceNetboxIpsecPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	body, err := getIpsecPolicyRequestBody(api, d)
+	if err != nil {
+		return err
+	}
+
+	var res ipsecPolicy
+	err = netboxRequest(api, http.MethodPost, "/vpn/ipsec-policies/", nil, body, &res)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.ID, 10))
+
+	return resourceNetboxIpsecPolicyRead(d, m)
+}
+
+func resourceNetboxIpsecPolicyRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	var policy ipsecPolicy
+	err := netboxRequest(api, http.MethodGet, fmt.Sprintf("/vpn/ipsec-policies/%s/", d.Id()), nil, nil, &policy)
+	if err != nil {
+		if isNetboxNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", policy.Name)
+	d.Set("proposal_ids", getIDsFromNetboxNestedObjects(policy.Proposals))
+	if policy.PfsGroup != nil {
+		d.Set("pfs_group", policy.PfsGroup.Value)
+	} else {
+		d.Set("pfs_group", nil)
+	}
+	d.Set("description", policy.Description)
+	d.Set("comments", policy.Comments)
+
+	cf := getCustomFieldsForState(api, d, policy.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	d.Set(tagsKey, getTagListForState(d, policy.Tags))
+	return nil
+}
+
+func resourceNetboxIpsecPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	body, err := getIpsecPolicyRequestBody(api, d)
+	if err != nil {
+		return err
+	}
+
+	err = netboxRequest(api, http.MethodPatch, fmt.Sprintf("/vpn/ipsec-policies/%s/", d.Id()), nil, body, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxIpsecPolicyRead(d, m)
+}
+
+func resourceNetboxIpsecPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	err := netboxRequest(api, http.MethodDelete, fmt.Sprintf("/vpn/ipsec-policies/%s/", d.Id()), nil, nil, nil)
+	if err != nil {
+		if isNetboxNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxIpsecPolicy_basic(t *testing.T) {
+	testSlug := "ipsec_pol"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_ipsec_proposal" "test" {
+  name                 = "%[1]s"
+  encryption_algorithm = "aes-256-gcm"
+}
+
+resource "netbox_ipsec_policy" "test" {
+  name         = "%[1]s"
+  proposal_ids = [netbox_ipsec_proposal.test.id]
+  pfs_group    = 14
+  description  = "%[1]s_description"
+  comments     = "%[1]s_comments"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ipsec_policy.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_ipsec_policy.test", "proposal_ids.#", "1"),
+					resource.TestCheckResourceAttr("netbox_ipsec_policy.test", "pfs_group", "14"),
+					resource.TestCheckResourceAttr("netbox_ipsec_policy.test", "description", testName+"_description"),
+					resource.TestCheckResourceAttr("netbox_ipsec_policy.test", "comments", testName+"_comments"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_ipsec_policy" "test" {
+  name = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ipsec_policy.test", "proposal_ids.#", "0"),
+					resource.TestCheckResourceAttr("netbox_ipsec_policy.test", "pfs_group", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_ipsec_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_ipsec_policy", &resource.Sweeper{
+		Name:         "netbox_ipsec_policy",
+		Dependencies: []string{"netbox_ipsec_profile"},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			var res struct {
+				Results []*ipsecPolicy `json:"results"`
+			}
+			err = netboxRequest(api, http.MethodGet, "/vpn/ipsec-policies/", nil, nil, &res)
+			if err != nil {
+				return err
+			}
+			for _, policy := range res.Results {
+				if !strings.HasPrefix(policy.Name, testPrefix) {
+					continue
+				}
+				err := netboxRequest(api, http.MethodDelete, fmt.Sprintf("/vpn/ipsec-policies/%d/", policy.ID), nil, nil, nil)
+				if err != nil {
+					return err
+				}
+				log.Print("[DEBUG] Deleted an ipsec policy")
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,186 @@
+package netbox
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxIpsecProfileModeOptions = []string{"esp", "ah"}
+
+// ipsecProfile is an IPsec profile as returned by the Netbox API. The go-netbox client does not support IPsec
+// profiles.
+type ipsecProfile struct {
+	ID           int64               `json:"id"`
+	Name         string              `json:"name"`
+	Description  string              `json:"description"`
+	Mode         *netboxStringChoice `json:"mode"`
+	IkePolicy    *netboxNestedObject `json:"ike_policy"`
+	IpsecPolicy  *netboxNestedObject `json:"ipsec_policy"`
+	Comments     string              `json:"comments"`
+	Tags         []*models.NestedTag `json:"tags"`
+	CustomFields interface{}         `json:"custom_fields"`
+}
+
+func resourceNetboxIpsecProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxIpsecProfileCreate,
+		Read:   resourceNetboxIpsecProfileRead,
+		Update: resourceNetboxIpsecProfileUpdate,
+		Delete: resourceNetboxIpsecProfileDelete,
+
+		Description: `:meta:subcategory:VPN Tunnels:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/ipsecprofile/):
+
+> This object represents an IPSec profile, which defines the IKE and IPSec policies to be used when establishing an IPSec tunnel.
+
+Use the ` + "`ipsec_profile_id`" + ` attribute of ` + "`netbox_vpn_tunnel`" + ` to assign a profile to a tunnel.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxIpsecProfileModeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxIpsecProfileModeOptions),
+			},
+			"ike_policy_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"ipsec_policy_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsModeKey: customFieldsModeSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getIpsecProfileRequestBody(api *client.NetBoxAPI, d *schema.ResourceData) (map[string]interface{}, error) {
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"name":         d.Get("name").(string),
+		"mode":         d.Get("mode").(string),
+		"ike_policy":   d.Get("ike_policy_id").(int),
+		"ipsec_policy": d.Get("ipsec_policy_id").(int),
+		"description":  d.Get("description").(string),
+		"comments":     d.Get("comments").(string),
+		"tags":         tags,
+	}
+	if cf := getCustomFieldsForAPI(api, d); cf != nil {
+		body["custom_fields"] = cf
+	}
+	return body, nil
+}
+
+func resourceNetboxIpsecProfileCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	body, err := getIpsecProfileRequestBody(api, d)
+	if err != nil {
+		return err
+	}
+
+	var res ipsecProfile
+	err = netboxRequest(api, http.MethodPost, "/vpn/ipsec-profiles/", nil, body, &res)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.ID, 10))
+
+	return resourceNetboxIpsecProfileRead(d, m)
+}
+
+func resourceNetboxIpsecProfileRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	var profile ipsecProfile
+	err := netboxRequest(api, http.MethodGet, fmt.Sprintf("/vpn/ipsec-profiles/%s/", d.Id()), nil, nil, &profile)
+	if err != nil {
+		if isNetboxNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", profile.Name)
+	if profile.Mode != nil {
+		d.Set("mode", profile.Mode.Value)
+	}
+	if profile.IkePolicy != nil {
+		d.Set("ike_policy_id", profile.IkePolicy.ID)
+	}
+	if profile.IpsecPolicy != nil {
+		d.Set("ipsec_policy_id", profile.IpsecPolicy.ID)
+	}
+	d.Set("description", profile.Description)
+	d.Set("comments", profile.Comments)
+
+	cf := getCustomFieldsForState(api, d, profile.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	d.Set(tagsKey, getTagListForState(d, profile.Tags))
+	return nil
+}
+
+func resourceNetboxIpsecProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	body, err := getIpsecProfileRequestBody(api, d)
+	if err != nil {
+		return err
+	}
+
+	err = netboxRequest(api, http.MethodPatch, fmt.Sprintf("/vpn/ipsec-profiles/%s/", d.Id()), nil, body, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxIpsecProfileRead(d, m)
+}
+
+func resourceNetboxIpsecProfileDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	err := netboxRequest(api, http.MethodDelete, fmt.Sprintf("/vpn/ipsec-profiles/%s/", d.Id()), nil, nil, nil)
+	if err != nil {
+		if isNetboxNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	return nil
+}
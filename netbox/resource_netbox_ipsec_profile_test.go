@@ -0,0 +1,126 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccNetboxIpsecProfileFullDependencies(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_ike_proposal" "test" {
+  name                  = "%[1]s"
+  authentication_method = "preshared-keys"
+  encryption_algorithm  = "aes-256-cbc"
+  group                 = 14
+}
+
+resource "netbox_ike_policy" "test" {
+  name         = "%[1]s"
+  version      = 2
+  proposal_ids = [netbox_ike_proposal.test.id]
+}
+
+resource "netbox_ipsec_proposal" "test" {
+  name                 = "%[1]s"
+  encryption_algorithm = "aes-256-gcm"
+}
+
+resource "netbox_ipsec_policy" "test" {
+  name         = "%[1]s"
+  proposal_ids = [netbox_ipsec_proposal.test.id]
+}
+`, testName)
+}
+
+func TestAccNetboxIpsecProfile_basic(t *testing.T) {
+	testSlug := "ipsec_prof"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxIpsecProfileFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_ipsec_profile" "test" {
+  name            = "%[1]s"
+  mode            = "esp"
+  ike_policy_id   = netbox_ike_policy.test.id
+  ipsec_policy_id = netbox_ipsec_policy.test.id
+  description     = "%[1]s_description"
+  comments        = "%[1]s_comments"
+  tags            = [netbox_tag.test.name]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ipsec_profile.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_ipsec_profile.test", "mode", "esp"),
+					resource.TestCheckResourceAttrPair("netbox_ipsec_profile.test", "ike_policy_id", "netbox_ike_policy.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_ipsec_profile.test", "ipsec_policy_id", "netbox_ipsec_policy.test", "id"),
+					resource.TestCheckResourceAttr("netbox_ipsec_profile.test", "description", testName+"_description"),
+					resource.TestCheckResourceAttr("netbox_ipsec_profile.test", "comments", testName+"_comments"),
+					resource.TestCheckResourceAttr("netbox_ipsec_profile.test", "tags.#", "1"),
+				),
+			},
+			{
+				Config: testAccNetboxIpsecProfileFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_ipsec_profile" "test" {
+  name            = "%[1]s"
+  mode            = "ah"
+  ike_policy_id   = netbox_ike_policy.test.id
+  ipsec_policy_id = netbox_ipsec_policy.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ipsec_profile.test", "mode", "ah"),
+					resource.TestCheckResourceAttr("netbox_ipsec_profile.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_ipsec_profile.test", "tags.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_ipsec_profile.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_ipsec_profile", &resource.Sweeper{
+		Name:         "netbox_ipsec_profile",
+		Dependencies: []string{"netbox_vpn_tunnel"},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			var res struct {
+				Results []*ipsecProfile `json:"results"`
+			}
+			err = netboxRequest(api, http.MethodGet, "/vpn/ipsec-profiles/", nil, nil, &res)
+			if err != nil {
+				return err
+			}
+			for _, profile := range res.Results {
+				if !strings.HasPrefix(profile.Name, testPrefix) {
+					continue
+				}
+				err := netboxRequest(api, http.MethodDelete, fmt.Sprintf("/vpn/ipsec-profiles/%d/", profile.ID), nil, nil, nil)
+				if err != nil {
+					return err
+				}
+				log.Print("[DEBUG] Deleted an ipsec profile")
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,197 @@
+package netbox
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ipsecProposal is an IPsec proposal as returned by the Netbox API. The go-netbox client does not support IPsec
+// proposals.
+type ipsecProposal struct {
+	ID                      int64               `json:"id"`
+	Name                    string              `json:"name"`
+	Description             string              `json:"description"`
+	EncryptionAlgorithm     *netboxStringChoice `json:"encryption_algorithm"`
+	AuthenticationAlgorithm *netboxStringChoice `json:"authentication_algorithm"`
+	SaLifetimeSeconds       *int64              `json:"sa_lifetime_seconds"`
+	SaLifetimeData          *int64              `json:"sa_lifetime_data"`
+	Comments                string              `json:"comments"`
+	Tags                    []*models.NestedTag `json:"tags"`
+	CustomFields            interface{}         `json:"custom_fields"`
+}
+
+func resourceNetboxIpsecProposal() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxIpsecProposalCreate,
+		Read:   resourceNetboxIpsecProposalRead,
+		Update: resourceNetboxIpsecProposalUpdate,
+		Delete: resourceNetboxIpsecProposalDelete,
+
+		Description: `:meta:subcategory:VPN Tunnels:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/ipsecproposal/):
+
+> An IPSec proposal defines a set of parameters used in negotiating security associations for IPSec tunnels. IPSec proposals defined in NetBox can be referenced by IPSec policies, which can in turn be referenced by IPSec profiles.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"encryption_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"encryption_algorithm", "authentication_algorithm"},
+				ValidateFunc: validation.StringInSlice(resourceNetboxVpnEncryptionAlgorithmOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxVpnEncryptionAlgorithmOptions),
+			},
+			"authentication_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"encryption_algorithm", "authentication_algorithm"},
+				ValidateFunc: validation.StringInSlice(resourceNetboxVpnAuthenticationAlgorithmOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxVpnAuthenticationAlgorithmOptions),
+			},
+			"sa_lifetime_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The security association lifetime in seconds.",
+			},
+			"sa_lifetime_data": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The security association lifetime in kilobytes.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsModeKey: customFieldsModeSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func getIpsecProposalRequestBody(api *client.NetBoxAPI, d *schema.ResourceData) (map[string]interface{}, error) {
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"name":                     d.Get("name").(string),
+		"encryption_algorithm":     d.Get("encryption_algorithm").(string),
+		"authentication_algorithm": d.Get("authentication_algorithm").(string),
+		"sa_lifetime_seconds":      getOptionalInt(d, "sa_lifetime_seconds"),
+		"sa_lifetime_data":         getOptionalInt(d, "sa_lifetime_data"),
+		"description":              d.Get("description").(string),
+		"comments":                 d.Get("comments").(string),
+		"tags":                     tags,
+	}
+	if cf := getCustomFieldsForAPI(api, d); cf != nil {
+		body["custom_fields"] = cf
+	}
+	return body, nil
+}
+
+func resourceNetboxIpsecProposalCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	body, err := getIpsecProposalRequestBody(api, d)
+	if err != nil {
+		return err
+	}
+
+	var res ipsecProposal
+	err = netboxRequest(api, http.MethodPost, "/vpn/ipsec-proposals/", nil, body, &res)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.ID, 10))
+
+	return resourceNetboxIpsecProposalRead(d, m)
+}
+
+func resourceNetboxIpsecProposalRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	var proposal ipsecProposal
+	err := netboxRequest(api, http.MethodGet, fmt.Sprintf("/vpn/ipsec-proposals/%s/", d.Id()), nil, nil, &proposal)
+	if err != nil {
+		if isNetboxNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", proposal.Name)
+	if proposal.EncryptionAlgorithm != nil {
+		d.Set("encryption_algorithm", proposal.EncryptionAlgorithm.Value)
+	} else {
+		d.Set("encryption_algorithm", nil)
+	}
+	if proposal.AuthenticationAlgorithm != nil {
+		d.Set("authentication_algorithm", proposal.AuthenticationAlgorithm.Value)
+	} else {
+		d.Set("authentication_algorithm", nil)
+	}
+	d.Set("sa_lifetime_seconds", proposal.SaLifetimeSeconds)
+	d.Set("sa_lifetime_data", proposal.SaLifetimeData)
+	d.Set("description", proposal.Description)
+	d.Set("comments", proposal.Comments)
+
+	cf := getCustomFieldsForState(api, d, proposal.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	d.Set(tagsKey, getTagListForState(d, proposal.Tags))
+	return nil
+}
+
+func resourceNetboxIpsecProposalUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	body, err := getIpsecProposalRequestBody(api, d)
+	if err != nil {
+		return err
+	}
+
+	err = netboxRequest(api, http.MethodPatch, fmt.Sprintf("/vpn/ipsec-proposals/%s/", d.Id()), nil, body, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxIpsecProposalRead(d, m)
+}
+
+func resourceNetboxIpsecProposalDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	err := netboxRequest(api, http.MethodDelete, fmt.Sprintf("/vpn/ipsec-proposals/%s/", d.Id()), nil, nil, nil)
+	if err != nil {
+		if isNetboxNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxIpsecProposal_basic(t *testing.T) {
+	testSlug := "ipsec_prop"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_ipsec_proposal" "test" {
+  name                     = "%[1]s"
+  encryption_algorithm     = "aes-256-gcm"
+  authentication_algorithm = "hmac-sha512"
+  sa_lifetime_seconds      = 3600
+  sa_lifetime_data         = 102400
+  description              = "%[1]s_description"
+  comments                 = "%[1]s_comments"
+  tags                     = [netbox_tag.test.name]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ipsec_proposal.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_ipsec_proposal.test", "encryption_algorithm", "aes-256-gcm"),
+					resource.TestCheckResourceAttr("netbox_ipsec_proposal.test", "authentication_algorithm", "hmac-sha512"),
+					resource.TestCheckResourceAttr("netbox_ipsec_proposal.test", "sa_lifetime_seconds", "3600"),
+					resource.TestCheckResourceAttr("netbox_ipsec_proposal.test", "sa_lifetime_data", "102400"),
+					resource.TestCheckResourceAttr("netbox_ipsec_proposal.test", "description", testName+"_description"),
+					resource.TestCheckResourceAttr("netbox_ipsec_proposal.test", "comments", testName+"_comments"),
+					resource.TestCheckResourceAttr("netbox_ipsec_proposal.test", "tags.#", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_ipsec_proposal" "test" {
+  name                 = "%[1]s"
+  encryption_algorithm = "aes-128-cbc"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ipsec_proposal.test", "encryption_algorithm", "aes-128-cbc"),
+					resource.TestCheckResourceAttr("netbox_ipsec_proposal.test", "authentication_algorithm", ""),
+					resource.TestCheckResourceAttr("netbox_ipsec_proposal.test", "sa_lifetime_seconds", "0"),
+					resource.TestCheckResourceAttr("netbox_ipsec_proposal.test", "sa_lifetime_data", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_ipsec_proposal.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_ipsec_proposal", &resource.Sweeper{
+		Name:         "netbox_ipsec_proposal",
+		Dependencies: []string{"netbox_ipsec_policy"},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			var res struct {
+				Results []*ipsecProposal `json:"results"`
+			}
+			err = netboxRequest(api, http.MethodGet, "/vpn/ipsec-proposals/", nil, nil, &res)
+			if err != nil {
+				return err
+			}
+			for _, proposal := range res.Results {
+				if !strings.HasPrefix(proposal.Name, testPrefix) {
+					continue
+				}
+				err := netboxRequest(api, http.MethodDelete, fmt.Sprintf("/vpn/ipsec-proposals/%d/", proposal.ID), nil, nil, nil)
+				if err != nil {
+					return err
+				}
+				log.Print("[DEBUG] Deleted an ipsec proposal")
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,204 @@
+package netbox
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceNetboxL2vpnTypeOptions = []string{"vpws", "vpls", "vxlan", "vxlan-evpn", "mpls-evpn", "pbb-evpn", "evpn-vpws", "epl", "evpl", "ep-lan", "evp-lan", "ep-tree", "evp-tree"}
+
+func resourceNetboxL2vpn() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxL2vpnCreate,
+		Read:   resourceNetboxL2vpnRead,
+		Update: resourceNetboxL2vpnUpdate,
+		Delete: resourceNetboxL2vpnDelete,
+
+		Description: `:meta:subcategory:VPN Tunnels:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/l2vpn/):
+
+> A L2VPN object is NetBox is a representation of a layer 2 bridge technology such as VXLAN, VPLS, or EPL. Each L2VPN can be identified by name as well as by an optional unique identifier (VNI would be an example). Once created, L2VPNs can be terminated to interfaces and VLANs.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"slug": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceNetboxL2vpnTypeOptions, false),
+				Description:  buildValidValueDescription(resourceNetboxL2vpnTypeOptions),
+			},
+			"identifier": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "A numeric identifier of the L2VPN, e.g. the VNI of a VXLAN.",
+			},
+			"import_target_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"export_target_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsModeKey: customFieldsModeSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// getL2vpnRequestBody returns the request body for the /vpn/l2vpns/ endpoint. The go-netbox client still uses the
+// /ipam/ paths of Netbox 3, which Netbox 4 moved to /vpn/.
+func getL2vpnRequestBody(api *client.NetBoxAPI, d *schema.ResourceData) (map[string]interface{}, error) {
+	slug := d.Get("slug").(string)
+	if slug == "" {
+		slug = getSlug(d.Get("name").(string))
+	}
+
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"name":           d.Get("name").(string),
+		"slug":           slug,
+		"type":           d.Get("type").(string),
+		"identifier":     getOptionalInt(d, "identifier"),
+		"import_targets": toInt64List(d.Get("import_target_ids")),
+		"export_targets": toInt64List(d.Get("export_target_ids")),
+		"tenant":         getOptionalInt(d, "tenant_id"),
+		"description":    d.Get("description").(string),
+		"comments":       d.Get("comments").(string),
+		"tags":           tags,
+	}
+	if cf := getCustomFieldsForAPI(api, d); cf != nil {
+		body["custom_fields"] = cf
+	}
+	return body, nil
+}
+
+func resourceNetboxL2vpnCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	body, err := getL2vpnRequestBody(api, d)
+	if err != nil {
+		return err
+	}
+
+	var res models.L2VPN
+	err = netboxRequest(api, http.MethodPost, "/vpn/l2vpns/", nil, body, &res)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.ID, 10))
+
+	return resourceNetboxL2vpnRead(d, m)
+}
+
+func resourceNetboxL2vpnRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	var l2vpn models.L2VPN
+	err := netboxRequest(api, http.MethodGet, fmt.Sprintf("/vpn/l2vpns/%s/", d.Id()), nil, nil, &l2vpn)
+	if err != nil {
+		if isNetboxNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", l2vpn.Name)
+	d.Set("slug", l2vpn.Slug)
+	if l2vpn.Type != nil {
+		d.Set("type", l2vpn.Type.Value)
+	}
+	d.Set("identifier", l2vpn.Identifier)
+	d.Set("import_target_ids", getIDsFromNestedRouteTargets(l2vpn.ImportTargets))
+	d.Set("export_target_ids", getIDsFromNestedRouteTargets(l2vpn.ExportTargets))
+	if l2vpn.Tenant != nil {
+		d.Set("tenant_id", l2vpn.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+	d.Set("description", l2vpn.Description)
+	d.Set("comments", l2vpn.Comments)
+
+	cf := getCustomFieldsForState(api, d, l2vpn.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	d.Set(tagsKey, getTagListForState(d, l2vpn.Tags))
+	return nil
+}
+
+func resourceNetboxL2vpnUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	body, err := getL2vpnRequestBody(api, d)
+	if err != nil {
+		return err
+	}
+
+	err = netboxRequest(api, http.MethodPatch, fmt.Sprintf("/vpn/l2vpns/%s/", d.Id()), nil, body, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxL2vpnRead(d, m)
+}
+
+func resourceNetboxL2vpnDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	err := netboxRequest(api, http.MethodDelete, fmt.Sprintf("/vpn/l2vpns/%s/", d.Id()), nil, nil, nil)
+	if err != nil {
+		if isNetboxNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package netbox
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNetboxL2vpnTermination() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxL2vpnTerminationCreate,
+		Read:   resourceNetboxL2vpnTerminationRead,
+		Update: resourceNetboxL2vpnTerminationUpdate,
+		Delete: resourceNetboxL2vpnTerminationDelete,
+
+		Description: `:meta:subcategory:VPN Tunnels:From the [official documentation](https://docs.netbox.dev/en/stable/models/vpn/l2vpntermination/):
+
+> A L2VPN termination is the attachment of an L2VPN to an interface or VLAN. Note that the L2VPNs of the following types may have only two terminations assigned to them: VPWS, EPL, EP-LAN, EP-TREE.`,
+
+		Schema: map[string]*schema.Schema{
+			"l2vpn_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"vlan_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"vlan_id", "device_interface_id", "virtual_machine_interface_id"},
+			},
+			"device_interface_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"vlan_id", "device_interface_id", "virtual_machine_interface_id"},
+			},
+			"virtual_machine_interface_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"vlan_id", "device_interface_id", "virtual_machine_interface_id"},
+			},
+			tagsKey: tagsSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// getL2vpnTerminationRequestBody returns the request body for the /vpn/l2vpn-terminations/ endpoint, which the
+// go-netbox client does not know under this path
+func getL2vpnTerminationRequestBody(api *client.NetBoxAPI, d *schema.ResourceData) (map[string]interface{}, error) {
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"l2vpn": d.Get("l2vpn_id").(int),
+		"tags":  tags,
+	}
+
+	vlanID := getOptionalInt(d, "vlan_id")
+	deviceInterfaceID := getOptionalInt(d, "device_interface_id")
+	vmInterfaceID := getOptionalInt(d, "virtual_machine_interface_id")
+
+	switch {
+	case vlanID != nil:
+		body["assigned_object_type"] = "ipam.vlan"
+		body["assigned_object_id"] = *vlanID
+	case deviceInterfaceID != nil:
+		body["assigned_object_type"] = "dcim.interface"
+		body["assigned_object_id"] = *deviceInterfaceID
+	case vmInterfaceID != nil:
+		body["assigned_object_type"] = "virtualization.vminterface"
+		body["assigned_object_id"] = *vmInterfaceID
+	}
+
+	return body, nil
+}
+
+func resourceNetboxL2vpnTerminationCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	body, err := getL2vpnTerminationRequestBody(api, d)
+	if err != nil {
+		return err
+	}
+
+	var res models.L2VPNTermination
+	err = netboxRequest(api, http.MethodPost, "/vpn/l2vpn-terminations/", nil, body, &res)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(res.ID, 10))
+
+	return resourceNetboxL2vpnTerminationRead(d, m)
+}
+
+func resourceNetboxL2vpnTerminationRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	var termination models.L2VPNTermination
+	err := netboxRequest(api, http.MethodGet, fmt.Sprintf("/vpn/l2vpn-terminations/%s/", d.Id()), nil, nil, &termination)
+	if err != nil {
+		if isNetboxNotFound(err) {
+			// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if termination.L2vpn != nil {
+		d.Set("l2vpn_id", termination.L2vpn.ID)
+	}
+
+	d.Set("vlan_id", nil)
+	d.Set("device_interface_id", nil)
+	d.Set("virtual_machine_interface_id", nil)
+	if termination.AssignedObjectType != nil && termination.AssignedObjectID != nil {
+		switch *termination.AssignedObjectType {
+		case "ipam.vlan":
+			d.Set("vlan_id", termination.AssignedObjectID)
+		case "dcim.interface":
+			d.Set("device_interface_id", termination.AssignedObjectID)
+		case "virtualization.vminterface":
+			d.Set("virtual_machine_interface_id", termination.AssignedObjectID)
+		}
+	}
+
+	d.Set(tagsKey, getTagListForState(d, termination.Tags))
+	return nil
+}
+
+func resourceNetboxL2vpnTerminationUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	body, err := getL2vpnTerminationRequestBody(api, d)
+	if err != nil {
+		return err
+	}
+
+	err = netboxRequest(api, http.MethodPatch, fmt.Sprintf("/vpn/l2vpn-terminations/%s/", d.Id()), nil, body, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceNetboxL2vpnTerminationRead(d, m)
+}
+
+func resourceNetboxL2vpnTerminationDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	err := netboxRequest(api, http.MethodDelete, fmt.Sprintf("/vpn/l2vpn-terminations/%s/", d.Id()), nil, nil, nil)
+	if err != nil {
+		if isNetboxNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxL2vpnTermination_basic(t *testing.T) {
+	testSlug := "l2vpn_term"
+	testName := testAccGetTestName(testSlug)
+	dependencies := testAccNetboxIPAddressFullDeviceDependencies(testName) + fmt.Sprintf(`
+resource "netbox_vlan" "test" {
+  name = "%[1]s"
+  vid  = 100
+}
+
+resource "netbox_l2vpn" "test" {
+  name       = "%[1]s"
+  type       = "vxlan-evpn"
+  identifier = 10200
+}`, testName)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: dependencies + `
+resource "netbox_l2vpn_termination" "test" {
+  l2vpn_id = netbox_l2vpn.test.id
+  vlan_id  = netbox_vlan.test.id
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_l2vpn_termination.test", "l2vpn_id", "netbox_l2vpn.test", "id"),
+					resource.TestCheckResourceAttrPair("netbox_l2vpn_termination.test", "vlan_id", "netbox_vlan.test", "id"),
+					resource.TestCheckResourceAttr("netbox_l2vpn_termination.test", "device_interface_id", "0"),
+				),
+			},
+			{
+				Config: dependencies + `
+resource "netbox_l2vpn_termination" "test" {
+  l2vpn_id            = netbox_l2vpn.test.id
+  device_interface_id = netbox_device_interface.test.id
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_l2vpn_termination.test", "device_interface_id", "netbox_device_interface.test", "id"),
+					resource.TestCheckResourceAttr("netbox_l2vpn_termination.test", "vlan_id", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_l2vpn_termination.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
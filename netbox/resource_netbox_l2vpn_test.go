@@ -0,0 +1,112 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxL2vpn_basic(t *testing.T) {
+	testSlug := "l2vpn"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_tenant" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_route_target" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_l2vpn" "test" {
+  name              = "%[1]s"
+  type              = "vxlan-evpn"
+  identifier        = 10100
+  import_target_ids = [netbox_route_target.test.id]
+  export_target_ids = [netbox_route_target.test.id]
+  tenant_id         = netbox_tenant.test.id
+  description       = "%[1]s_description"
+  comments          = "%[1]s_comments"
+  tags              = [netbox_tag.test.name]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "slug", getSlug(testName)),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "type", "vxlan-evpn"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "identifier", "10100"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "import_target_ids.#", "1"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "export_target_ids.#", "1"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "description", testName+"_description"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "comments", testName+"_comments"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "tags.#", "1"),
+					resource.TestCheckResourceAttrPair("netbox_l2vpn.test", "tenant_id", "netbox_tenant.test", "id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_l2vpn" "test" {
+  name = "%[1]s"
+  type = "vpls"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "type", "vpls"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "identifier", "0"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "import_target_ids.#", "0"),
+					resource.TestCheckResourceAttr("netbox_l2vpn.test", "tenant_id", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_l2vpn.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_l2vpn", &resource.Sweeper{
+		Name:         "netbox_l2vpn",
+		Dependencies: []string{},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			var res struct {
+				Results []*models.L2VPN `json:"results"`
+			}
+			err = netboxRequest(api, http.MethodGet, "/vpn/l2vpns/", nil, nil, &res)
+			if err != nil {
+				return err
+			}
+			for _, l2vpn := range res.Results {
+				if l2vpn.Name == nil || !strings.HasPrefix(*l2vpn.Name, testPrefix) {
+					continue
+				}
+				err := netboxRequest(api, http.MethodDelete, fmt.Sprintf("/vpn/l2vpns/%d/", l2vpn.ID), nil, nil, nil)
+				if err != nil {
+					return err
+				}
+				log.Print("[DEBUG] Deleted an l2vpn")
+			}
+			return nil
+		},
+	})
+}
@@ -27,9 +27,10 @@ func resourceNetboxPrefix() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"prefix": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.IsCIDR,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validatePrefix,
+				DiffSuppressFunc: suppressEquivalentCIDRDiff,
 			},
 			"status": {
 				Type:         schema.TypeString,
@@ -69,8 +70,9 @@ func resourceNetboxPrefix() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
-			customFieldsKey: customFieldsSchema,
-			tagsKey:         tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsModeKey: customFieldsModeSchema,
+			tagsKey:             tagsSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -115,12 +117,15 @@ func resourceNetboxPrefixCreate(d *schema.ResourceData, m interface{}) error {
 		data.Role = int64ToPtr(int64(roleID.(int)))
 	}
 
-	cf, ok := d.GetOk(customFieldsKey)
-	if ok {
+	if cf := getCustomFieldsForAPI(api, d); cf != nil {
 		data.CustomFields = cf
 	}
 
-	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
+	data.Tags = tags
 
 	params := ipam.NewIpamPrefixesCreateParams().WithData(&data)
 	res, err := api.Ipam.IpamPrefixesCreate(params, nil)
@@ -190,12 +195,12 @@ func resourceNetboxPrefixRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("role_id", nil)
 	}
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	cf := getCustomFieldsForState(api, d, res.GetPayload().CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
 
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getTagListForState(d, res.GetPayload().Tags))
 	// FIGURE OUT NESTED VRF AND NESTED VLAN (from maybe interfaces?)
 
 	return nil
@@ -204,6 +209,22 @@ func resourceNetboxPrefixRead(d *schema.ResourceData, m interface{}) error {
 func resourceNetboxPrefixUpdate(d *schema.ResourceData, m interface{}) error {
 	api := m.(*client.NetBoxAPI)
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	data, err := getWritablePrefixFromResourceData(api, d)
+	if err != nil {
+		return err
+	}
+
+	params := ipam.NewIpamPrefixesUpdateParams().WithID(id).WithData(data)
+	_, err = api.Ipam.IpamPrefixesUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+	return resourceNetboxPrefixRead(d, m)
+}
+
+// getWritablePrefixFromResourceData returns the prefix described by the resource data.
+// It is shared with netbox_available_prefix.
+func getWritablePrefixFromResourceData(api *client.NetBoxAPI, d *schema.ResourceData) (*models.WritablePrefix, error) {
 	data := models.WritablePrefix{}
 	prefix := d.Get("prefix").(string)
 	status := d.Get("status").(string)
@@ -242,18 +263,17 @@ func resourceNetboxPrefixUpdate(d *schema.ResourceData, m interface{}) error {
 		data.Role = int64ToPtr(int64(roleID.(int)))
 	}
 
-	if cf, ok := d.GetOk(customFieldsKey); ok {
+	if cf := getCustomFieldsForAPI(api, d); cf != nil {
 		data.CustomFields = cf
 	}
 
-	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
-
-	params := ipam.NewIpamPrefixesUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Ipam.IpamPrefixesUpdate(params, nil)
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return resourceNetboxPrefixRead(d, m)
+	data.Tags = tags
+
+	return &data, nil
 }
 
 func resourceNetboxPrefixDelete(d *schema.ResourceData, m interface{}) error {
@@ -263,6 +263,41 @@ resource "netbox_prefix" "test" {
 	})
 }
 
+func TestAccNetboxPrefix_normalization(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "netbox_prefix" "test" {
+  prefix = "2001:DB8:0:4400::/56"
+  status = "active"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_prefix.test", "prefix", "2001:db8:0:4400::/56"),
+				),
+			},
+			{
+				Config: `
+resource "netbox_prefix" "test" {
+  prefix = "2001:DB8:0:4400::/56"
+  status = "active"
+}`,
+				PlanOnly: true,
+			},
+			{
+				Config: `
+resource "netbox_prefix" "test" {
+  prefix = "10.45.0.1/24"
+  status = "active"
+}`,
+				ExpectError: regexp.MustCompile(`did you mean "10.45.0.0/24"`),
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_prefix", &resource.Sweeper{
 		Name:         "netbox_prefix",
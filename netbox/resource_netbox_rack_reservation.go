@@ -60,7 +60,10 @@ func resourceNetboxRackReservation() *schema.Resource {
 func resourceNetboxRackReservationCreate(d *schema.ResourceData, m interface{}) error {
 	api := m.(*client.NetBoxAPI)
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
 
 	params := dcim.NewDcimRackReservationsCreateParams().WithData(
 		&models.WritableRackReservation{
@@ -126,7 +129,7 @@ func resourceNetboxRackReservationRead(d *schema.ResourceData, m interface{}) er
 
 	d.Set("comments", rackRes.Comments)
 
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getTagListForState(d, res.GetPayload().Tags))
 	return nil
 }
 
@@ -135,7 +138,10 @@ func resourceNetboxRackReservationUpdate(d *schema.ResourceData, m interface{})
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
 
 	data := models.WritableRackReservation{
 		Rack:        getOptionalInt(d, "rack_id"),
@@ -149,7 +155,7 @@ func resourceNetboxRackReservationUpdate(d *schema.ResourceData, m interface{})
 
 	params := dcim.NewDcimRackReservationsPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Dcim.DcimRackReservationsPartialUpdate(params, nil)
+	_, err = api.Dcim.DcimRackReservationsPartialUpdate(params, nil)
 	if err != nil {
 		return err
 	}
@@ -65,7 +65,10 @@ func resourceNetboxRackRoleCreate(d *schema.ResourceData, m interface{}) error {
 	color := d.Get("color_hex").(string)
 	description := getOptionalStr(d, "description", false)
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
 
 	params := dcim.NewDcimRackRolesCreateParams().WithData(
 		&models.RackRole{
@@ -111,7 +114,7 @@ func resourceNetboxRackRoleRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("slug", rackRole.Slug)
 	d.Set("description", rackRole.Description)
 	d.Set("color_hex", rackRole.Color)
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getTagListForState(d, res.GetPayload().Tags))
 	return nil
 }
 
@@ -139,12 +142,15 @@ func resourceNetboxRackRoleUpdate(d *schema.ResourceData, m interface{}) error {
 	data.Description = getOptionalStr(d, "description", true)
 	data.Color = color
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
 	data.Tags = tags
 
 	params := dcim.NewDcimRackRolesPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Dcim.DcimRackRolesPartialUpdate(params, nil)
+	_, err = api.Dcim.DcimRackRolesPartialUpdate(params, nil)
 	if err != nil {
 		return err
 	}
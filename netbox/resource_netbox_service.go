@@ -1,6 +1,8 @@
 package netbox
 
 import (
+	"fmt"
+	"net/http"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client"
@@ -23,39 +25,72 @@ func resourceNetboxService() *schema.Resource {
 
 > A service represents a layer four TCP or UDP service available on a device or virtual machine. For example, you might want to document that an HTTP service is running on a device. Each service includes a name, protocol, and port number; for example, "SSH (TCP/22)" or "DNS (UDP/53)."
 >
-> A service may optionally be bound to one or more specific IP addresses belonging to its parent device or VM. (If no IP addresses are bound, the service is assumed to be reachable via any assigned IP address.`,
+> A service may optionally be bound to one or more specific IP addresses belonging to its parent device or VM. (If no IP addresses are bound, the service is assumed to be reachable via any assigned IP address.
+
+A service can be created from a service template by setting ` + "`service_template_id`" + ` instead of ` + "`name`" + `, ` + "`protocol`" + ` and ` + "`ports`" + `. The name, protocol and ports of the template are copied to the service whenever the service is created or updated.`,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "service_template_id"},
 				ValidateFunc: validation.StringLenBetween(1, 100),
 			},
 			"virtual_machine_id": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_id", "virtual_machine_id"},
+			},
+			"device_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_id", "virtual_machine_id"},
+			},
+			"service_template_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The service template to create the service from. Conflicts with `name`, `protocol`, `port` and `ports`.",
 			},
 			"protocol": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"protocol", "service_template_id"},
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(resourceNetboxServiceProtocolOptions, false)),
 				Description:      buildValidValueDescription(resourceNetboxServiceProtocolOptions),
 			},
 			"port": {
 				Type:         schema.TypeInt,
 				Optional:     true,
-				ExactlyOneOf: []string{"port", "ports"},
+				ExactlyOneOf: []string{"port", "ports", "service_template_id"},
 				Deprecated:   "This field is deprecated. Please use the new \"ports\" attribute instead.",
 			},
 			"ports": {
 				Type:         schema.TypeSet,
 				Optional:     true,
-				ExactlyOneOf: []string{"port", "ports"},
+				Computed:     true,
+				ExactlyOneOf: []string{"port", "ports", "service_template_id"},
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"ip_address_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeInt,
 				},
+				Description: "The IP addresses of the parent device or virtual machine the service is bound to.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
 			},
-			customFieldsKey: customFieldsSchema,
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsModeKey: customFieldsModeSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -89,15 +124,18 @@ func resourceNetboxServiceCreate(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	dataVirtualMachineID := int64(d.Get("virtual_machine_id").(int))
-	data.VirtualMachine = &dataVirtualMachineID
+	data.Device = getOptionalInt(d, "device_id")
+	data.VirtualMachine = getOptionalInt(d, "virtual_machine_id")
+
+	if err := setServiceDataFromTemplate(api, d, &data); err != nil {
+		return err
+	}
 
 	data.Tags = []*models.NestedTag{}
 	data.Ipaddresses = []int64{}
 
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
+	if cf := getCustomFieldsForAPI(api, d); cf != nil {
+		data.CustomFields = cf
 	}
 
 	params := ipam.NewIpamServicesCreateParams().WithData(&data)
@@ -128,12 +166,33 @@ func resourceNetboxServiceRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	d.Set("name", res.GetPayload().Name)
-	d.Set("protocol", res.GetPayload().Protocol.Value)
-	d.Set("ports", res.GetPayload().Ports)
-	d.Set("virtual_machine_id", res.GetPayload().VirtualMachine.ID)
+	service := res.GetPayload()
+	d.Set("name", service.Name)
+	d.Set("protocol", service.Protocol.Value)
+	d.Set("ports", service.Ports)
+	d.Set("description", service.Description)
 
-	cf := getCustomFields(res.GetPayload().CustomFields)
+	if service.Device != nil {
+		d.Set("device_id", service.Device.ID)
+	} else {
+		d.Set("device_id", nil)
+	}
+
+	if service.VirtualMachine != nil {
+		d.Set("virtual_machine_id", service.VirtualMachine.ID)
+	} else {
+		d.Set("virtual_machine_id", nil)
+	}
+
+	var ipAddressIDs []int64
+	for _, ipAddress := range service.Ipaddresses {
+		ipAddressIDs = append(ipAddressIDs, ipAddress.ID)
+	}
+	d.Set("ip_address_ids", ipAddressIDs)
+
+	d.Set(tagsKey, getTagListForState(d, service.Tags))
+
+	cf := getCustomFieldsForState(api, d, service.CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
@@ -166,19 +225,39 @@ func resourceNetboxServiceUpdate(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	data.Tags = []*models.NestedTag{}
-	data.Ipaddresses = []int64{}
+	if err := setServiceDataFromTemplate(api, d, &data); err != nil {
+		return err
+	}
 
-	dataVirtualMachineID := int64(d.Get("virtual_machine_id").(int))
-	data.VirtualMachine = &dataVirtualMachineID
+	data.Description = getOptionalStr(d, "description", true)
 
-	cf, ok := d.GetOk(customFieldsKey)
-	if ok {
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
+	data.Tags = tags
+
+	data.Ipaddresses = toInt64List(d.Get("ip_address_ids"))
+
+	data.Device = getOptionalInt(d, "device_id")
+	data.VirtualMachine = getOptionalInt(d, "virtual_machine_id")
+
+	// An omitted parent is left untouched by the API, so moving a service between a device and a virtual machine
+	// has to clear the previous parent explicitly
+	if d.HasChanges("device_id", "virtual_machine_id") && !d.IsNewResource() {
+		parent := map[string]interface{}{"device": data.Device, "virtual_machine": data.VirtualMachine}
+		err = netboxRequest(api, http.MethodPatch, fmt.Sprintf("/ipam/services/%d/", id), nil, parent, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cf := getCustomFieldsForAPI(api, d); cf != nil {
 		data.CustomFields = cf
 	}
 
 	params := ipam.NewIpamServicesUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Ipam.IpamServicesUpdate(params, nil)
+	_, err = api.Ipam.IpamServicesUpdate(params, nil)
 	if err != nil {
 		return err
 	}
@@ -201,3 +280,25 @@ func resourceNetboxServiceDelete(d *schema.ResourceData, m interface{}) error {
 	}
 	return nil
 }
+
+// setServiceDataFromTemplate copies the name, protocol and ports of the configured service template, if any, to data
+func setServiceDataFromTemplate(api *client.NetBoxAPI, d *schema.ResourceData, data *models.WritableService) error {
+	templateID, ok := d.GetOk("service_template_id")
+	if !ok {
+		return nil
+	}
+
+	params := ipam.NewIpamServiceTemplatesReadParams().WithID(int64(templateID.(int)))
+	res, err := api.Ipam.IpamServiceTemplatesRead(params, nil)
+	if err != nil {
+		return err
+	}
+
+	template := res.GetPayload()
+	data.Name = template.Name
+	if template.Protocol != nil {
+		data.Protocol = template.Protocol.Value
+	}
+	data.Ports = template.Ports
+	return nil
+}
@@ -0,0 +1,174 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetboxServiceTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxServiceTemplateCreate,
+		Read:   resourceNetboxServiceTemplateRead,
+		Update: resourceNetboxServiceTemplateUpdate,
+		Delete: resourceNetboxServiceTemplateDelete,
+
+		Description: `:meta:subcategory:IP Address Management (IPAM):From the [official documentation](https://docs.netbox.dev/en/stable/models/ipam/servicetemplate/):
+
+> Service templates can be used to instantiate services on devices and virtual machines.
+>
+> A service template defines a name, protocol, and port number(s), and may optionally include a description. Each service template must have a unique name.`,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"protocol": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(resourceNetboxServiceProtocolOptions, false)),
+				Description:      buildValidValueDescription(resourceNetboxServiceProtocolOptions),
+			},
+			"ports": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntBetween(1, 65535),
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			tagsKey:             tagsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsModeKey: customFieldsModeSchema,
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNetboxServiceTemplateCreate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+
+	data, err := getServiceTemplateFromResourceData(api, d)
+	if err != nil {
+		return err
+	}
+
+	params := ipam.NewIpamServiceTemplatesCreateParams().WithData(data)
+	res, err := api.Ipam.IpamServiceTemplatesCreate(params, nil)
+	if err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatInt(res.GetPayload().ID, 10))
+
+	return resourceNetboxServiceTemplateRead(d, m)
+}
+
+func resourceNetboxServiceTemplateRead(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamServiceTemplatesReadParams().WithID(id)
+
+	res, err := api.Ipam.IpamServiceTemplatesRead(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*ipam.IpamServiceTemplatesReadDefault); ok {
+			errorcode := errresp.Code()
+			if errorcode == 404 {
+				// If the ID is updated to blank, this tells Terraform the resource no longer exists (maybe it was destroyed out of band). Just like the destroy callback, the Read function should gracefully handle this case. https://www.terraform.io/docs/extend/writing-custom-providers.html
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	template := res.GetPayload()
+	d.Set("name", template.Name)
+	if template.Protocol != nil {
+		d.Set("protocol", template.Protocol.Value)
+	}
+	d.Set("ports", template.Ports)
+	d.Set("description", template.Description)
+	d.Set("comments", template.Comments)
+	d.Set(tagsKey, getTagListForState(d, template.Tags))
+
+	cf := getCustomFieldsForState(api, d, template.CustomFields)
+	if cf != nil {
+		d.Set(customFieldsKey, cf)
+	}
+
+	return nil
+}
+
+func resourceNetboxServiceTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+
+	data, err := getServiceTemplateFromResourceData(api, d)
+	if err != nil {
+		return err
+	}
+
+	params := ipam.NewIpamServiceTemplatesUpdateParams().WithID(id).WithData(data)
+	_, err = api.Ipam.IpamServiceTemplatesUpdate(params, nil)
+	if err != nil {
+		return err
+	}
+	return resourceNetboxServiceTemplateRead(d, m)
+}
+
+func resourceNetboxServiceTemplateDelete(d *schema.ResourceData, m interface{}) error {
+	api := m.(*client.NetBoxAPI)
+	id, _ := strconv.ParseInt(d.Id(), 10, 64)
+	params := ipam.NewIpamServiceTemplatesDeleteParams().WithID(id)
+	_, err := api.Ipam.IpamServiceTemplatesDelete(params, nil)
+	if err != nil {
+		if errresp, ok := err.(*ipam.IpamServiceTemplatesDeleteDefault); ok {
+			if errresp.Code() == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+func getServiceTemplateFromResourceData(api *client.NetBoxAPI, d *schema.ResourceData) (*models.WritableServiceTemplate, error) {
+	data := models.WritableServiceTemplate{}
+
+	data.Name = strToPtr(d.Get("name").(string))
+	data.Protocol = strToPtr(d.Get("protocol").(string))
+	data.Ports = toInt64List(d.Get("ports"))
+	data.Description = getOptionalStr(d, "description", true)
+	data.Comments = getOptionalStr(d, "comments", true)
+
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return nil, err
+	}
+	data.Tags = tags
+
+	if cf := getCustomFieldsForAPI(api, d); cf != nil {
+		data.CustomFields = cf
+	}
+
+	return &data, nil
+}
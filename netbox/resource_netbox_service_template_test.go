@@ -0,0 +1,99 @@
+package netbox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/fbreckle/go-netbox/netbox/client"
+	"github.com/fbreckle/go-netbox/netbox/client/ipam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNetboxServiceTemplate_basic(t *testing.T) {
+	testSlug := "svc_tmpl_basic"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+}
+
+resource "netbox_service_template" "test" {
+  name = "%[1]s"
+  protocol = "tcp"
+  ports = [80, 443]
+  description = "%[1]s"
+  comments = "%[1]s"
+  tags = [netbox_tag.test.name]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_service_template.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_service_template.test", "protocol", "tcp"),
+					resource.TestCheckResourceAttr("netbox_service_template.test", "ports.#", "2"),
+					resource.TestCheckTypeSetElemAttr("netbox_service_template.test", "ports.*", "80"),
+					resource.TestCheckTypeSetElemAttr("netbox_service_template.test", "ports.*", "443"),
+					resource.TestCheckResourceAttr("netbox_service_template.test", "description", testName),
+					resource.TestCheckResourceAttr("netbox_service_template.test", "comments", testName),
+					resource.TestCheckResourceAttr("netbox_service_template.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_service_template.test", "tags.0", testName),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_service_template" "test" {
+  name = "%[1]s"
+  protocol = "udp"
+  ports = [53]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_service_template.test", "protocol", "udp"),
+					resource.TestCheckResourceAttr("netbox_service_template.test", "ports.#", "1"),
+					resource.TestCheckResourceAttr("netbox_service_template.test", "description", ""),
+					resource.TestCheckResourceAttr("netbox_service_template.test", "comments", ""),
+					resource.TestCheckResourceAttr("netbox_service_template.test", "tags.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_service_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	resource.AddTestSweepers("netbox_service_template", &resource.Sweeper{
+		Name:         "netbox_service_template",
+		Dependencies: []string{"netbox_service"},
+		F: func(region string) error {
+			m, err := sharedClientForRegion(region)
+			if err != nil {
+				return fmt.Errorf("Error getting client: %s", err)
+			}
+			api := m.(*client.NetBoxAPI)
+			params := ipam.NewIpamServiceTemplatesListParams()
+			res, err := api.Ipam.IpamServiceTemplatesList(params, nil)
+			if err != nil {
+				return err
+			}
+			for _, template := range res.GetPayload().Results {
+				if strings.HasPrefix(*template.Name, testPrefix) {
+					deleteParams := ipam.NewIpamServiceTemplatesDeleteParams().WithID(template.ID)
+					_, err := api.Ipam.IpamServiceTemplatesDelete(deleteParams, nil)
+					if err != nil {
+						return err
+					}
+					log.Print("[DEBUG] Deleted a service template")
+				}
+			}
+			return nil
+		},
+	})
+}
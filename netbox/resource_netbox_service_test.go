@@ -3,6 +3,7 @@ package netbox
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -65,6 +66,115 @@ resource "netbox_service" "test" {
 	})
 }
 
+func TestAccNetboxService_device(t *testing.T) {
+	testSlug := "svc_device"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxIPAddressFullDeviceDependencies(testName) + fmt.Sprintf(`
+resource "netbox_ip_address" "test" {
+  ip_address = "10.46.0.1/24"
+  status = "active"
+  interface_id = netbox_device_interface.test.id
+  object_type = "dcim.interface"
+}
+
+resource "netbox_service" "test" {
+  name = "%s"
+  device_id = netbox_device.test.id
+  ip_address_ids = [netbox_ip_address.test.id]
+  ports = [443]
+  protocol = "tcp"
+  description = "https"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_service.test", "name", testName),
+					resource.TestCheckResourceAttrPair("netbox_service.test", "device_id", "netbox_device.test", "id"),
+					resource.TestCheckResourceAttr("netbox_service.test", "virtual_machine_id", "0"),
+					resource.TestCheckResourceAttr("netbox_service.test", "ip_address_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("netbox_service.test", "ip_address_ids.*", "netbox_ip_address.test", "id"),
+					resource.TestCheckResourceAttr("netbox_service.test", "description", "https"),
+				),
+			},
+			{
+				Config: testAccNetboxIPAddressFullDeviceDependencies(testName) + fmt.Sprintf(`
+resource "netbox_ip_address" "test" {
+  ip_address = "10.46.0.1/24"
+  status = "active"
+  interface_id = netbox_device_interface.test.id
+  object_type = "dcim.interface"
+}
+
+resource "netbox_service" "test" {
+  name = "%s"
+  device_id = netbox_device.test.id
+  ports = [443]
+  protocol = "tcp"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_service.test", "ip_address_ids.#", "0"),
+					resource.TestCheckResourceAttr("netbox_service.test", "description", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_service.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxService_template(t *testing.T) {
+	testSlug := "svc_template"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxServiceFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_service_template" "test" {
+  name = "%s"
+  protocol = "udp"
+  ports = [53]
+}
+
+resource "netbox_service" "test" {
+  service_template_id = netbox_service_template.test.id
+  virtual_machine_id = netbox_virtual_machine.test.id
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_service.test", "name", testName),
+					resource.TestCheckResourceAttr("netbox_service.test", "protocol", "udp"),
+					resource.TestCheckResourceAttr("netbox_service.test", "ports.#", "1"),
+					resource.TestCheckResourceAttr("netbox_service.test", "ports.0", "53"),
+				),
+			},
+			{
+				Config: testAccNetboxServiceFullDependencies(testName) + fmt.Sprintf(`
+resource "netbox_service_template" "test" {
+  name = "%s"
+  protocol = "udp"
+  ports = [53]
+}
+
+resource "netbox_service" "test" {
+  service_template_id = netbox_service_template.test.id
+  virtual_machine_id = netbox_virtual_machine.test.id
+  protocol = "tcp"
+}`, testName),
+				ExpectError: regexp.MustCompile("only one of `protocol,service_template_id` can be specified"),
+			},
+		},
+	})
+}
+
 func TestAccNetboxService_customFields(t *testing.T) {
 	testSlug := "svc_custom_fields"
 	testName := testAccGetTestName(testSlug)
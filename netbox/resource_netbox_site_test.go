@@ -134,6 +134,117 @@ resource "netbox_site" "test" {
 	})
 }
 
+func TestAccNetboxSite_typedCustomFields(t *testing.T) {
+	testSlug := "site_typed_cf"
+	testName := testAccGetTestName(testSlug)
+	testField := strings.ReplaceAll(testAccGetTestName(testSlug), "-", "_")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_custom_field" "integer" {
+  name          = "%[1]s_int"
+  type          = "integer"
+  content_types = ["dcim.site"]
+}
+resource "netbox_custom_field" "boolean" {
+  name          = "%[1]s_bool"
+  type          = "boolean"
+  content_types = ["dcim.site"]
+}
+resource "netbox_custom_field" "json" {
+  name          = "%[1]s_json"
+  type          = "json"
+  content_types = ["dcim.site"]
+}
+resource "netbox_site" "test" {
+  name   = "%[2]s"
+  status = "active"
+  custom_fields = {
+    "${netbox_custom_field.integer.name}" = "42"
+    "${netbox_custom_field.boolean.name}" = "true"
+    "${netbox_custom_field.json.name}"    = jsonencode({ foo = [1, 2] })
+  }
+}`, testField, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_site.test", "custom_fields."+testField+"_int", "42"),
+					resource.TestCheckResourceAttr("netbox_site.test", "custom_fields."+testField+"_bool", "true"),
+					resource.TestCheckResourceAttr("netbox_site.test", "custom_fields."+testField+"_json", `{"foo":[1,2]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetboxSite_customFieldsMerge(t *testing.T) {
+	testSlug := "site_cf_merge"
+	testName := testAccGetTestName(testSlug)
+	testField := strings.ReplaceAll(testAccGetTestName(testSlug), "-", "_")
+	dependencies := fmt.Sprintf(`
+resource "netbox_custom_field" "managed" {
+  name          = "%[1]s_managed"
+  type          = "text"
+  content_types = ["dcim.site"]
+}
+resource "netbox_custom_field" "foreign" {
+  name          = "%[1]s_foreign"
+  type          = "text"
+  content_types = ["dcim.site"]
+}`, testField)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name   = "%[1]s"
+  status = "active"
+  custom_fields = {
+    "${netbox_custom_field.managed.name}" = "a"
+    "${netbox_custom_field.foreign.name}" = "b"
+  }
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_site.test", "custom_fields_mode", "authoritative"),
+					resource.TestCheckResourceAttr("netbox_site.test", "custom_fields.%", "2"),
+				),
+			},
+			{
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name               = "%[1]s"
+  status             = "active"
+  custom_fields_mode = "merge"
+  custom_fields = {
+    "${netbox_custom_field.managed.name}" = "c"
+    "${netbox_custom_field.foreign.name}" = "b"
+  }
+}`, testName),
+			},
+			{
+				// The foreign custom field is not managed anymore and keeps its value in Netbox
+				Config: dependencies + fmt.Sprintf(`
+resource "netbox_site" "test" {
+  name               = "%[1]s"
+  status             = "active"
+  custom_fields_mode = "merge"
+  custom_fields = {
+    "${netbox_custom_field.managed.name}" = "c"
+  }
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_site.test", "custom_fields_mode", "merge"),
+					resource.TestCheckResourceAttr("netbox_site.test", "custom_fields.%", "1"),
+					resource.TestCheckResourceAttr("netbox_site.test", "custom_fields."+testField+"_managed", "c"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetboxSite_fieldUpdate(t *testing.T) {
 	testSlug := "site_field_update"
 	testName := testAccGetTestName(testSlug)
@@ -64,6 +64,86 @@ resource "netbox_tag" "test" {
 	})
 }
 
+func testAccNetboxTagReferenceBySlug(testName string) string {
+	return fmt.Sprintf(`
+resource "netbox_tag" "test" {
+  name = "%[1]s"
+  slug = "%[1]s_slug"
+}
+
+resource "netbox_site" "test" {
+  name = "%[1]s"
+  status = "active"
+  tags = [netbox_tag.test.slug]
+}`, testName)
+}
+
+func TestAccNetboxTag_referenceBySlug(t *testing.T) {
+	testSlug := "tag_refSlug"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetboxTagReferenceBySlug(testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_site.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_site.test", "tags.0", testName+"_slug"),
+				),
+			},
+			{
+				// The tags are read back from Netbox, which returns them by name
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_site.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_site.test", "tags.0", testName+"_slug"),
+				),
+			},
+			{
+				Config:   testAccNetboxTagReferenceBySlug(testName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccNetboxTag_autoCreate(t *testing.T) {
+	testSlug := "tag_autoCreate"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "netbox" {
+  auto_create_tags = true
+}
+
+resource "netbox_tenant" "test" {
+  name = "%[1]s"
+  tags = ["%[1]s"]
+}
+
+data "netbox_tags" "test" {
+  filter {
+    name  = "name"
+    value = "%[1]s"
+  }
+  depends_on = [netbox_tenant.test]
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_tenant.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("netbox_tenant.test", "tags.0", testName),
+					resource.TestCheckResourceAttr("data.netbox_tags.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("data.netbox_tags.test", "tags.0.slug", getSlug(testName)),
+				),
+			},
+		},
+	})
+}
+
 func init() {
 	resource.AddTestSweepers("netbox_tag", &resource.Sweeper{
 		Name:         "netbox_tag",
@@ -36,8 +36,9 @@ func resourceNetboxToken() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: validation.IsCIDR,
+					Type:             schema.TypeString,
+					ValidateFunc:     validateIPAddressWithMask,
+					DiffSuppressFunc: suppressEquivalentCIDRDiff,
 				},
 			},
 			"write_enabled": {
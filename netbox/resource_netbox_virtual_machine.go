@@ -99,7 +99,8 @@ func resourceNetboxVirtualMachine() *schema.Resource {
 				Optional:    true,
 				Description: "This is best managed through the use of `jsonencode` and a map of settings.",
 			},
-			customFieldsKey: customFieldsSchema,
+			customFieldsKey:     customFieldsSchema,
+			customFieldsModeKey: customFieldsModeSchema,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -193,10 +194,12 @@ func resourceNetboxVirtualMachineCreate(ctx context.Context, d *schema.ResourceD
 	data.Status = d.Get("status").(string)
 
 	tags, diags := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	if diags.HasError() {
+		return diags
+	}
 	data.Tags = tags
-	ct, ok := d.GetOk(customFieldsKey)
-	if ok {
-		data.CustomFields = ct
+	if cf := getCustomFieldsForAPI(api, d); cf != nil {
+		data.CustomFields = cf
 	}
 
 	params := virtualization.NewVirtualizationVirtualMachinesCreateParams().WithData(&data)
@@ -314,9 +317,9 @@ func resourceNetboxVirtualMachineRead(ctx context.Context, d *schema.ResourceDat
 	} else {
 		d.Set("status", nil)
 	}
-	d.Set(tagsKey, getTagListFromNestedTagList(vm.Tags))
+	d.Set(tagsKey, getTagListForState(d, vm.Tags))
 
-	cf := getCustomFields(vm.CustomFields)
+	cf := getCustomFieldsForState(api, d, vm.CustomFields)
 	if cf != nil {
 		d.Set(customFieldsKey, cf)
 	}
@@ -409,9 +412,11 @@ func resourceNetboxVirtualMachineUpdate(ctx context.Context, d *schema.ResourceD
 	}
 
 	tags, diags := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	if diags.HasError() {
+		return diags
+	}
 	data.Tags = tags
-	cf, ok := d.GetOk(customFieldsKey)
-	if ok {
+	if cf := getCustomFieldsForAPI(api, d); cf != nil {
 		data.CustomFields = cf
 	}
 
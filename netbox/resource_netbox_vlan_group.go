@@ -91,7 +91,11 @@ func resourceNetboxVlanGroupCreate(d *schema.ResourceData, m interface{}) error
 		data.ScopeID = int64ToPtr(int64(scopeID.(int)))
 	}
 
-	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
+	data.Tags = tags
 
 	params := ipam.NewIpamVlanGroupsCreateParams().WithData(&data)
 	res, err := api.Ipam.IpamVlanGroupsCreate(params, nil)
@@ -128,7 +132,7 @@ func resourceNetboxVlanGroupRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("min_vid", vlanGroup.MinVid)
 	d.Set("max_vid", vlanGroup.MaxVid)
 	d.Set("description", vlanGroup.Description)
-	d.Set(tagsKey, getTagListFromNestedTagList(vlanGroup.Tags))
+	d.Set(tagsKey, getTagListForState(d, vlanGroup.Tags))
 
 	if vlanGroup.ScopeType != nil {
 		d.Set("scope_type", vlanGroup.ScopeType)
@@ -166,10 +170,14 @@ func resourceNetboxVlanGroupUpdate(d *schema.ResourceData, m interface{}) error
 		data.ScopeID = int64ToPtr(int64(scopeID.(int)))
 	}
 
-	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
+	data.Tags = tags
 
 	params := ipam.NewIpamVlanGroupsUpdateParams().WithID(id).WithData(&data)
-	_, err := api.Ipam.IpamVlanGroupsUpdate(params, nil)
+	_, err = api.Ipam.IpamVlanGroupsUpdate(params, nil)
 	if err != nil {
 		return err
 	}
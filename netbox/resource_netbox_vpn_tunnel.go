@@ -1,6 +1,8 @@
 package netbox
 
 import (
+	"fmt"
+	"net/http"
 	"strconv"
 
 	"github.com/fbreckle/go-netbox/netbox/client"
@@ -54,6 +56,10 @@ func resourceNetboxVpnTunnel() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"ipsec_profile_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
 			tagsKey: tagsSchema,
 		},
 		Importer: &schema.ResourceImporter{
@@ -75,8 +81,12 @@ func resourceNetboxVpnTunnelCreate(d *schema.ResourceData, m interface{}) error
 	data.Description = getOptionalStr(d, "description", false)
 	data.Tenant = getOptionalInt(d, "tenant_id")
 	data.TunnelID = getOptionalInt(d, "tunnel_id")
+	data.IpsecProfile = getOptionalInt(d, "ipsec_profile_id")
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
 	data.Tags = tags
 
 	params := vpn.NewVpnTunnelsCreateParams().WithData(&data)
@@ -131,7 +141,21 @@ func resourceNetboxVpnTunnelRead(d *schema.ResourceData, m interface{}) error {
 
 	d.Set("description", tunnel.Description)
 
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	// The go-netbox tunnel model does not include the IPsec profile
+	var profile struct {
+		IpsecProfile *netboxNestedObject `json:"ipsec_profile"`
+	}
+	err = netboxRequest(api, http.MethodGet, fmt.Sprintf("/vpn/tunnels/%d/", id), nil, nil, &profile)
+	if err != nil {
+		return err
+	}
+	if profile.IpsecProfile != nil {
+		d.Set("ipsec_profile_id", profile.IpsecProfile.ID)
+	} else {
+		d.Set("ipsec_profile_id", nil)
+	}
+
+	d.Set(tagsKey, getTagListForState(d, res.GetPayload().Tags))
 	return nil
 }
 
@@ -149,17 +173,29 @@ func resourceNetboxVpnTunnelUpdate(d *schema.ResourceData, m interface{}) error
 	data.Description = getOptionalStr(d, "description", false)
 	data.Tenant = getOptionalInt(d, "tenant_id")
 	data.TunnelID = getOptionalInt(d, "tunnel_id")
+	data.IpsecProfile = getOptionalInt(d, "ipsec_profile_id")
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
 	data.Tags = tags
 
 	params := vpn.NewVpnTunnelsUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Vpn.VpnTunnelsUpdate(params, nil)
+	_, err = api.Vpn.VpnTunnelsUpdate(params, nil)
 	if err != nil {
 		return err
 	}
 
+	// An omitted IPsec profile is left untouched by the API, so it has to be cleared explicitly
+	if data.IpsecProfile == nil && d.HasChange("ipsec_profile_id") {
+		err = netboxRequest(api, http.MethodPatch, fmt.Sprintf("/vpn/tunnels/%d/", id), nil, map[string]interface{}{"ipsec_profile": nil}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceNetboxVpnTunnelRead(d, m)
 }
 
@@ -78,7 +78,10 @@ func resourceNetboxVpnTunnelTerminationCreate(d *schema.ResourceData, m interfac
 
 	data.OutsideIP = getOptionalInt(d, "outside_ip_address_id")
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
 	data.Tags = tags
 
 	params := vpn.NewVpnTunnelTerminationsCreateParams().WithData(&data)
@@ -130,7 +133,7 @@ func resourceNetboxVpnTunnelTerminationRead(d *schema.ResourceData, m interface{
 		d.Set("outside_ip_address_id", tunnelTermination.OutsideIP.ID)
 	}
 
-	d.Set(tagsKey, getTagListFromNestedTagList(res.GetPayload().Tags))
+	d.Set(tagsKey, getTagListForState(d, res.GetPayload().Tags))
 	return nil
 }
 
@@ -158,12 +161,15 @@ func resourceNetboxVpnTunnelTerminationUpdate(d *schema.ResourceData, m interfac
 
 	data.OutsideIP = getOptionalInt(d, "outside_ip_address_id")
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
 	data.Tags = tags
 
 	params := vpn.NewVpnTunnelTerminationsUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Vpn.VpnTunnelTerminationsUpdate(params, nil)
+	_, err = api.Vpn.VpnTunnelTerminationsUpdate(params, nil)
 	if err != nil {
 		return err
 	}
@@ -44,6 +44,20 @@ func resourceNetboxVrf() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 21),
 			},
+			"import_target_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"export_target_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
 
 			tagsKey: tagsSchema,
 		},
@@ -73,10 +87,14 @@ func resourceNetboxVrfCreate(d *schema.ResourceData, m interface{}) error {
 		data.Rd = &rd
 	}
 
-	data.Tags, _ = getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
+	data.Tags = tags
 
-	data.ExportTargets = []int64{}
-	data.ImportTargets = []int64{}
+	data.ExportTargets = toInt64List(d.Get("export_target_ids"))
+	data.ImportTargets = toInt64List(d.Get("import_target_ids"))
 
 	params := ipam.NewIpamVrfsCreateParams().WithData(&data)
 
@@ -122,6 +140,8 @@ func resourceNetboxVrfRead(d *schema.ResourceData, m interface{}) error {
 	} else {
 		d.Set("tenant_id", nil)
 	}
+	d.Set("import_target_ids", getIDsFromNestedRouteTargets(vrf.ImportTargets))
+	d.Set("export_target_ids", getIDsFromNestedRouteTargets(vrf.ExportTargets))
 	return nil
 }
 
@@ -134,12 +154,15 @@ func resourceNetboxVrfUpdate(d *schema.ResourceData, m interface{}) error {
 	name := d.Get("name").(string)
 	enforceUnique := d.Get("enforce_unique").(bool)
 
-	tags, _ := getNestedTagListFromResourceDataSet(api, d.Get(tagsKey))
+	tags, err := getNestedTagList(api, d.Get(tagsKey))
+	if err != nil {
+		return err
+	}
 
 	data.Name = &name
 	data.Tags = tags
-	data.ExportTargets = []int64{}
-	data.ImportTargets = []int64{}
+	data.ExportTargets = toInt64List(d.Get("export_target_ids"))
+	data.ImportTargets = toInt64List(d.Get("import_target_ids"))
 	data.Description = getOptionalStr(d, "description", true)
 	data.EnforceUnique = enforceUnique
 
@@ -152,7 +175,7 @@ func resourceNetboxVrfUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 	params := ipam.NewIpamVrfsPartialUpdateParams().WithID(id).WithData(&data)
 
-	_, err := api.Ipam.IpamVrfsPartialUpdate(params, nil)
+	_, err = api.Ipam.IpamVrfsPartialUpdate(params, nil)
 	if err != nil {
 		return err
 	}
@@ -178,3 +201,11 @@ func resourceNetboxVrfDelete(d *schema.ResourceData, m interface{}) error {
 	}
 	return nil
 }
+
+func getIDsFromNestedRouteTargets(nestedRouteTargets []*models.NestedRouteTarget) []int64 {
+	var routeTargets []int64
+	for _, routeTarget := range nestedRouteTargets {
+		routeTargets = append(routeTargets, routeTarget.ID)
+	}
+	return routeTargets
+}
@@ -227,3 +227,66 @@ func init() {
 		},
 	})
 }
+
+func TestAccNetboxVrf_routeTargets(t *testing.T) {
+	testSlug := "vrt"
+	testName := testAccGetTestName(testSlug)
+	resource.ParallelTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_route_target" "import" {
+  name = "%[1]si"
+}
+
+resource "netbox_route_target" "export" {
+  name = "%[1]se"
+}
+
+resource "netbox_vrf" "test" {
+  name              = "%[1]s"
+  import_target_ids = [netbox_route_target.import.id, netbox_route_target.export.id]
+  export_target_ids = [netbox_route_target.export.id]
+}
+
+data "netbox_route_targets" "importing" {
+  filter {
+    name  = "importing_vrf_id"
+    value = netbox_vrf.test.id
+  }
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vrf.test", "import_target_ids.#", "2"),
+					resource.TestCheckResourceAttr("netbox_vrf.test", "export_target_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("netbox_vrf.test", "export_target_ids.*", "netbox_route_target.export", "id"),
+					resource.TestCheckResourceAttr("data.netbox_route_targets.importing", "route_targets.#", "2"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "netbox_route_target" "import" {
+  name = "%[1]si"
+}
+
+resource "netbox_route_target" "export" {
+  name = "%[1]se"
+}
+
+resource "netbox_vrf" "test" {
+  name = "%[1]s"
+}`, testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_vrf.test", "import_target_ids.#", "0"),
+					resource.TestCheckResourceAttr("netbox_vrf.test", "export_target_ids.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_vrf.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -30,18 +30,22 @@ var tagsSchemaRead = &schema.Schema{
 	Set:      schema.HashString,
 }
 
+// Color of tags created by the auto_create_tags provider option
+const autoCreatedTagColor = "9e9e9e"
+
+// getNestedTagListFromResourceDataSet resolves the configured tags to Netbox tags. Tags are
+// referenced by name or by slug. Depending on the provider options, missing tags are created
+// and tags that cannot be mapped to a unique Netbox tag are an error instead of a warning.
 func getNestedTagListFromResourceDataSet(client *client.NetBoxAPI, d interface{}) ([]*models.NestedTag, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
+	opts := getClientOptions(client)
+
 	tagList := d.(*schema.Set).List()
 	tags := []*models.NestedTag{}
 	for _, tag := range tagList {
 		tagString := tag.(string)
-		params := extras.NewExtrasTagsListParams()
-		params.Name = &tagString
-		limit := int64(2) // We search for a unique tag. Having two hits suffices to know its not unique.
-		params.Limit = &limit
-		res, err := client.Extras.ExtrasTagsList(params, nil)
+		results, err := findTags(client, tagString)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -50,23 +54,38 @@ func getNestedTagListFromResourceDataSet(client *client.NetBoxAPI, d interface{}
 			})
 			return tags, diags
 		}
-		payload := res.GetPayload()
-		switch *payload.Count {
-		case int64(0):
+		if len(results) == 0 && opts.AutoCreateTags {
+			created, err := createTag(client, tagString)
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Error creating tag %s in netbox", tag.(string)),
+					Detail:   err.Error(),
+				})
+				return tags, diags
+			}
+			results = append(results, created)
+		}
+		switch len(results) {
+		case 0:
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
 				Summary:  fmt.Sprintf("Error retrieving tag %s from netbox", tag.(string)),
 				Detail:   fmt.Sprintf("Could not locate referenced tag %s in netbox", tag.(string)),
 			})
 			return tags, diags
-		case int64(1):
+		case 1:
 			tags = append(tags, &models.NestedTag{
-				Name: payload.Results[0].Name,
-				Slug: payload.Results[0].Slug,
+				Name: results[0].Name,
+				Slug: results[0].Slug,
 			})
 		default:
+			severity := diag.Warning
+			if opts.StrictTags {
+				severity = diag.Error
+			}
 			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Warning,
+				Severity: severity,
 				Summary:  fmt.Sprintf("Error retrieving tag %s from netbox", tag.(string)),
 				Detail:   fmt.Sprintf("Could not map tag %s to unique tag in netbox", tag.(string)),
 			})
@@ -76,6 +95,64 @@ func getNestedTagListFromResourceDataSet(client *client.NetBoxAPI, d interface{}
 	return tags, diags
 }
 
+// findTags returns the tags whose name matches the given reference. If there is no such tag,
+// the tags whose slug matches are returned.
+func findTags(client *client.NetBoxAPI, ref string) ([]*models.Tag, error) {
+	limit := int64(2) // We search for a unique tag. Having two hits suffices to know its not unique.
+
+	params := extras.NewExtrasTagsListParams()
+	params.Name = &ref
+	params.Limit = &limit
+	res, err := client.Extras.ExtrasTagsList(params, nil)
+	if err != nil {
+		return nil, err
+	}
+	if *res.GetPayload().Count > 0 {
+		return res.GetPayload().Results, nil
+	}
+
+	params = extras.NewExtrasTagsListParams()
+	params.Slug = &ref
+	params.Limit = &limit
+	res, err = client.Extras.ExtrasTagsList(params, nil)
+	if err != nil {
+		return nil, err
+	}
+	return res.GetPayload().Results, nil
+}
+
+// createTag creates a tag with the given name. Parallel resources referencing the same new tag
+// race to create it, so if the creation fails, the tag created by the winner is returned. A tag
+// with a different name but the same slug is reported as an error.
+func createTag(client *client.NetBoxAPI, name string) (*models.Tag, error) {
+	data := &models.Tag{
+		Name:  &name,
+		Slug:  strToPtr(getSlug(name)),
+		Color: autoCreatedTagColor,
+	}
+	params := extras.NewExtrasTagsCreateParams().WithData(data)
+	res, err := client.Extras.ExtrasTagsCreate(params, nil)
+	if err != nil {
+		limit := int64(1)
+		findParams := extras.NewExtrasTagsListParams()
+		findParams.Name = &name
+		findParams.Limit = &limit
+		if found, findErr := client.Extras.ExtrasTagsList(findParams, nil); findErr == nil && len(found.GetPayload().Results) == 1 {
+			return found.GetPayload().Results[0], nil
+		}
+
+		// A different tag may have the same slug, which must not be adopted in place of the new tag
+		findParams = extras.NewExtrasTagsListParams()
+		findParams.Slug = data.Slug
+		findParams.Limit = &limit
+		if found, findErr := client.Extras.ExtrasTagsList(findParams, nil); findErr == nil && len(found.GetPayload().Results) == 1 {
+			return nil, fmt.Errorf("cannot create tag %q, its slug %q is already used by tag %q", name, *data.Slug, *found.GetPayload().Results[0].Name)
+		}
+		return nil, err
+	}
+	return res.GetPayload(), nil
+}
+
 func getTagListFromNestedTagList(nestedTags []*models.NestedTag) []string {
 	tags := []string{}
 	for _, nestedTag := range nestedTags {
@@ -83,3 +160,43 @@ func getTagListFromNestedTagList(nestedTags []*models.NestedTag) []string {
 	}
 	return tags
 }
+
+// getTagListForState returns the names of the given tags. Tags that are referenced by their slug
+// in the resource are returned as slug, so they do not show up as a difference.
+func getTagListForState(d *schema.ResourceData, nestedTags []*models.NestedTag) []string {
+	configured, _ := d.Get(tagsKey).(*schema.Set)
+	return getTagListForConfiguredTags(configured, nestedTags)
+}
+
+// getTagListForConfiguredTags is like getTagListForState for tags that are configured in a
+// nested block. configured may be nil.
+func getTagListForConfiguredTags(configured *schema.Set, nestedTags []*models.NestedTag) []string {
+	known := make(map[string]bool)
+	if configured != nil {
+		for _, tag := range configured.List() {
+			known[tag.(string)] = true
+		}
+	}
+
+	tags := []string{}
+	for _, nestedTag := range nestedTags {
+		if nestedTag.Slug != nil && known[*nestedTag.Slug] && !known[*nestedTag.Name] {
+			tags = append(tags, *nestedTag.Slug)
+		} else {
+			tags = append(tags, *nestedTag.Name)
+		}
+	}
+	return tags
+}
+
+// getNestedTagList is like getNestedTagListFromResourceDataSet, but returns the error
+// diagnostics as error. Warnings are dropped.
+func getNestedTagList(client *client.NetBoxAPI, d interface{}) ([]*models.NestedTag, error) {
+	tags, diags := getNestedTagListFromResourceDataSet(client, d)
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			return nil, fmt.Errorf("%s: %s", d.Summary, d.Detail)
+		}
+	}
+	return tags, nil
+}
@@ -1,9 +1,13 @@
 package netbox
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/fbreckle/go-netbox/netbox/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -26,3 +30,90 @@ func TestGetTagListFromNestedTagList(t *testing.T) {
 	}
 	assert.Equal(t, flat, expected)
 }
+
+func TestGetTagListForState(t *testing.T) {
+	tags := []*models.NestedTag{
+		{
+			Name: strToPtr("Foo"),
+			Slug: strToPtr("foo"),
+		},
+		{
+			Name: strToPtr("Bar"),
+			Slug: strToPtr("bar"),
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{tagsKey: tagsSchema}, map[string]interface{}{
+		tagsKey: []interface{}{"Foo", "bar"},
+	})
+
+	assert.ElementsMatch(t, []string{"Foo", "bar"}, getTagListForState(d, tags))
+}
+
+func TestGetTagListForConfiguredTags(t *testing.T) {
+	tags := []*models.NestedTag{
+		{
+			Name: strToPtr("Foo"),
+			Slug: strToPtr("foo"),
+		},
+	}
+
+	configured := schema.NewSet(schema.HashString, []interface{}{"foo"})
+	assert.Equal(t, []string{"foo"}, getTagListForConfiguredTags(configured, tags))
+	assert.Equal(t, []string{"Foo"}, getTagListForConfiguredTags(nil, tags))
+}
+
+func TestCreateTagConflict(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		byName   string
+		bySlug   string
+		expected string
+		err      string
+	}{
+		{
+			name:     "CreatedInParallel",
+			byName:   `{"id": 1, "name": "My Tag", "slug": "my-tag"}`,
+			bySlug:   `{"id": 1, "name": "My Tag", "slug": "my-tag"}`,
+			expected: "My Tag",
+		},
+		{
+			name:   "SlugTaken",
+			bySlug: `{"id": 2, "name": "my-tag", "slug": "my-tag"}`,
+			err:    `cannot create tag "My Tag", its slug "my-tag" is already used by tag "my-tag"`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method == http.MethodPost {
+					w.WriteHeader(http.StatusBadRequest)
+					fmt.Fprint(w, `{"slug": ["tag with this slug already exists."]}`)
+					return
+				}
+				result := tt.bySlug
+				if r.URL.Query().Has("name") {
+					result = tt.byName
+				}
+				if result == "" {
+					fmt.Fprint(w, `{"count": 0, "results": []}`)
+					return
+				}
+				fmt.Fprintf(w, `{"count": 1, "results": [%s]}`, result)
+			}))
+			defer ts.Close()
+
+			config := Config{APIToken: "07b12b765127747e4afd56cb531b7bf9c61f3c30", ServerURL: ts.URL}
+			api, err := config.Client()
+			assert.NoError(t, err)
+
+			tag, err := createTag(api, "My Tag")
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, *tag.Name)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,9 +22,20 @@ func float64ToPtr(i float64) *float64 {
 	return &i
 }
 
+// toList returns the elements of a TypeSet or TypeList attribute value
+func toList(a interface{}) []interface{} {
+	switch v := a.(type) {
+	case *schema.Set:
+		return v.List()
+	case []interface{}:
+		return v
+	}
+	return nil
+}
+
 func toStringList(a interface{}) []string {
 	strList := []string{}
-	for _, str := range a.(*schema.Set).List() {
+	for _, str := range toList(a) {
 		strList = append(strList, str.(string))
 	}
 	return strList
@@ -31,7 +43,7 @@ func toStringList(a interface{}) []string {
 
 func toInt64List(a interface{}) []int64 {
 	intList := []int64{}
-	for _, number := range a.(*schema.Set).List() {
+	for _, number := range toList(a) {
 		if n, ok := number.(int); ok {
 			intList = append(intList, int64(n))
 		} else if n, ok := number.(int64); ok {
@@ -43,13 +55,11 @@ func toInt64List(a interface{}) []int64 {
 
 func toInt64PtrList(a interface{}) []*int64 {
 	intList := []*int64{}
-	if set, ok := a.(*schema.Set); ok {
-		for _, number := range set.List() {
-			if n, ok := number.(int); ok {
-				intList = append(intList, int64ToPtr(int64(n)))
-			} else if n, ok := number.(int64); ok {
-				intList = append(intList, int64ToPtr(n))
-			}
+	for _, number := range toList(a) {
+		if n, ok := number.(int); ok {
+			intList = append(intList, int64ToPtr(int64(n)))
+		} else if n, ok := number.(int64); ok {
+			intList = append(intList, int64ToPtr(n))
 		}
 	}
 	return intList
@@ -77,6 +87,14 @@ func buildValidValueDescription(options []string) string {
 	return "Valid values are " + joinStringWithFinalConjunction(quoted, ", ", "and")
 }
 
+func buildValidIntValueDescription(options []int) string {
+	var values []string
+	for _, option := range options {
+		values = append(values, strconv.Itoa(option))
+	}
+	return buildValidValueDescription(values)
+}
+
 func getOptionalStr(d *schema.ResourceData, key string, useSpace bool) string {
 	strVal := ""
 	// check if key is set
@@ -132,3 +150,15 @@ func jsonSemanticCompare(a, b string) (equal bool, err error) {
 
 	return reflect.DeepEqual(aDecoded, bDecoded), nil
 }
+
+// chunkSlice splits s into consecutive chunks of at most size elements.
+func chunkSlice[T any](s []T, size int) [][]T {
+	var chunks [][]T
+	for size < len(s) {
+		s, chunks = s[size:], append(chunks, s[0:size:size])
+	}
+	if len(s) > 0 {
+		chunks = append(chunks, s)
+	}
+	return chunks
+}
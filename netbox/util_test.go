@@ -1,7 +1,10 @@
 package netbox
 
 import (
+	"reflect"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestJoinStringWithFinalConjunction(t *testing.T) {
@@ -84,3 +87,72 @@ func TestJsonSemanticCompareUnequal(t *testing.T) {
 		t.Errorf("expected 'a' and 'b' to be semantically unequal\n\na: %s\nb: %s\n", a, b)
 	}
 }
+
+func TestChunkSlice(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		list     []int
+		size     int
+		expected [][]int
+	}{
+		{
+			name:     "Empty",
+			list:     []int{},
+			size:     2,
+			expected: nil,
+		},
+		{
+			name:     "Even",
+			list:     []int{1, 2, 3, 4},
+			size:     2,
+			expected: [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name:     "Remainder",
+			list:     []int{1, 2, 3, 4, 5},
+			size:     2,
+			expected: [][]int{{1, 2}, {3, 4}, {5}},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := chunkSlice(tt.list, tt.size)
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestToInt64List(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		value interface{}
+	}{
+		{name: "List", value: []interface{}{1, 2}},
+		{name: "Set", value: schema.NewSet(schema.HashInt, []interface{}{1, 2})},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := toInt64List(tt.value)
+			if !reflect.DeepEqual(actual, []int64{1, 2}) {
+				t.Fatalf("got %v, expected [1 2]", actual)
+			}
+		})
+	}
+}
+
+func TestToStringList(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		value interface{}
+	}{
+		{name: "List", value: []interface{}{"foo"}},
+		{name: "Set", value: schema.NewSet(schema.HashString, []interface{}{"foo"})},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := toStringList(tt.value)
+			if !reflect.DeepEqual(actual, []string{"foo"}) {
+				t.Fatalf("got %v, expected [foo]", actual)
+			}
+		})
+	}
+}